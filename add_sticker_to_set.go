@@ -0,0 +1,60 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// AddStickerToSetRequest represents a request to add a new sticker to a set created by the bot.
+//
+// See "addStickerToSet" https://core.telegram.org/bots/api#addstickertoset
+type AddStickerToSetRequest struct {
+	// (Required) User identifier of sticker set owner.
+	UserID int64 `json:"user_id"`
+
+	// (Required) Sticker set name.
+	Name string `json:"name"`
+
+	// (Required) A JSON-serialized object with information about the added sticker. If exactly the same sticker had already been added
+	// to the set, then the set isn't changed.
+	Sticker InputSticker `json:"sticker"`
+}
+
+// AddStickerToSet adds a new sticker to a set created by the bot. Emoji sticker sets can have up to 200 stickers.
+// Other sticker sets can have up to 120 stickers.
+//
+// See "addStickerToSet" https://core.telegram.org/bots/api#addstickertoset
+func (b *Bot) AddStickerToSet(request AddStickerToSetRequest) error {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "addStickerToSet", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
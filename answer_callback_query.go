@@ -0,0 +1,71 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// AnswerCallbackQueryRequest represents a request to send an answer to a callback query sent from an inline keyboard.
+//
+// See "answerCallbackQuery" https://core.telegram.org/bots/api#answercallbackquery
+type AnswerCallbackQueryRequest struct {
+	// (Required) Unique identifier for the query to be answered.
+	CallbackQueryID string `json:"callback_query_id"`
+
+	// (Optional) Text of the notification. If not specified, nothing will be shown to the user, 0-200 characters.
+	Text *string `json:"text,omitempty"`
+
+	// (Optional) If True, an alert will be shown by the client instead of a notification at the top of the chat screen. Defaults to false.
+	ShowAlert *bool `json:"show_alert,omitempty"`
+
+	// (Optional) URL that will be opened by the user's client. If you have created a Game and accepted the conditions via @BotFather,
+	// specify the URL that opens your game - note that this will only work if the query comes from a callback_game button.
+	//
+	// Otherwise, you may use links like t.me/your_bot?start=XXXX that open your bot with a parameter.
+	URL *string `json:"url,omitempty"`
+
+	// (Optional) The maximum amount of time in seconds that the result of the callback query may be cached client-side.
+	// Telegram apps will support caching starting in version 3.14. Defaults to 0.
+	CacheTime *int `json:"cache_time,omitempty"`
+}
+
+// AnswerCallbackQuery sends an answer to a callback query sent from an inline keyboard.
+// The answer will be displayed to the user as a notification at the top of the chat screen or as an alert.
+//
+// See "answerCallbackQuery" https://core.telegram.org/bots/api#answercallbackquery
+func (b *Bot) AnswerCallbackQuery(request AnswerCallbackQueryRequest) error {
+	b.callbackQueries.markAnswered(request.CallbackQueryID)
+
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "answerCallbackQuery", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// AnswerPreCheckoutQueryRequest represents a request to respond to a pre-checkout query.
+//
+// See "answerPreCheckoutQuery" https://core.telegram.org/bots/api#answerprecheckoutquery
+type AnswerPreCheckoutQueryRequest struct {
+	// (Required) Unique identifier for the query to be answered.
+	PreCheckoutQueryID string `json:"pre_checkout_query_id"`
+
+	// (Required) Specify True if everything is alright (goods are available, etc.) and the bot is ready to proceed with the order.
+	// Use False if there are any problems.
+	Ok bool `json:"ok"`
+
+	// (Optional) Required if ok is False. Error message in human readable form that explains the reason for failure to proceed with
+	// the checkout (e.g. "Sorry, somebody just bought the last of our amazing black T-shirts while you were busy filling out your payment
+	// details. Please choose a different color or garment!"). Telegram will display this message to the user.
+	ErrorMessage *string `json:"error_message,omitempty"`
+}
+
+// AnswerPreCheckoutQuery responds to a pre-checkout query. Once the user has confirmed their payment and shipping details, the Bot API sends
+// the final confirmation in the form of an Update with the field pre_checkout_query.
+//
+// # Note
+//
+// The Bot API must receive an answer within 10 seconds after the pre-checkout query was sent.
+//
+// See "answerPreCheckoutQuery" https://core.telegram.org/bots/api#answerprecheckoutquery
+func (b *Bot) AnswerPreCheckoutQuery(request AnswerPreCheckoutQueryRequest) error {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "answerPreCheckoutQuery", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
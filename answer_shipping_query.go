@@ -0,0 +1,64 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// AnswerShippingQueryRequest represents a request to reply to a shipping query.
+//
+// See "answerShippingQuery" https://core.telegram.org/bots/api#answershippingquery
+type AnswerShippingQueryRequest struct {
+	// (Required) Unique identifier for the query to be answered.
+	ShippingQueryID string `json:"shipping_query_id"`
+
+	// (Required) Pass True if delivery to the specified address is possible and False if there are any problems
+	// (for example, if delivery to the specified address is not possible).
+	Ok bool `json:"ok"`
+
+	// (Optional) Required if ok is True. A JSON-serialized array of available shipping options.
+	ShippingOptions []ShippingOption `json:"shipping_options,omitempty"`
+
+	// (Optional) Required if ok is False. Error message in human readable form that explains why it is impossible to complete the order
+	// (e.g. “Sorry, delivery to your desired address is unavailable”). Telegram will display this message to the user.
+	ErrorMessage *string `json:"error_message,omitempty"`
+}
+
+// AnswerShippingQuery replies to a shipping query. If you sent an invoice requesting a shipping address and the parameter is_flexible was specified,
+// the Bot API will send an Update with a shipping_query field to the bot.
+//
+// See "answerShippingQuery" https://core.telegram.org/bots/api#answershippingquery
+func (b *Bot) AnswerShippingQuery(request AnswerShippingQueryRequest) error {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "answerShippingQuery", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
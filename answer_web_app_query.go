@@ -0,0 +1,56 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// AnswerWebAppQueryRequest represents a request to set the result of an interaction with a Web App.
+//
+// See "answerWebAppQuery" https://core.telegram.org/bots/api#answerwebappquery
+type AnswerWebAppQueryRequest struct {
+	// (Required) Unique identifier for the query to be answered.
+	WebAppQueryID string `json:"web_app_query_id"`
+
+	// (Required) A JSON-serialized object describing the message to be sent.
+	Result InlineQueryResult `json:"result"`
+}
+
+// AnswerWebAppQuery sets the result of an interaction with a Web App and sends a corresponding message on behalf of the user
+// to the chat from which the query originated. On success, a SentWebAppMessage object is returned.
+//
+// See "answerWebAppQuery" https://core.telegram.org/bots/api#answerwebappquery
+func (b *Bot) AnswerWebAppQuery(request AnswerWebAppQueryRequest) (SentWebAppMessage, error) {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return SentWebAppMessage{}, fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "answerWebAppQuery", requestPayload)
+	if err != nil {
+		return SentWebAppMessage{}, err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      SentWebAppMessage  `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return SentWebAppMessage{}, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return SentWebAppMessage{}, fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return response.Result, nil
+}
@@ -0,0 +1,107 @@
+package telegram
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// DefaultCallbackQueryAnswerTimeout is how long AutoAnswerCallbackQuery waits for the handler before answering on its behalf.
+// Telegram rejects answers to queries that are older than about 15 seconds, so the default leaves some headroom for the request itself.
+const DefaultCallbackQueryAnswerTimeout = 10 * time.Second
+
+// AutoAnswerCallbackQuery returns a middleware that answers any callback query the handler did not answer itself,
+// so the user never sees an endless progress bar.
+//
+// The query is answered with an empty notification as soon as the handler returns, or once timeout elapses if the
+// handler is still running. A timeout of zero or less selects DefaultCallbackQueryAnswerTimeout.
+// Updates other than callback queries are passed through untouched.
+func AutoAnswerCallbackQuery(timeout time.Duration) Middleware {
+	if timeout <= 0 {
+		timeout = DefaultCallbackQueryAnswerTimeout
+	}
+
+	return func(next HandlerFunc) HandlerFunc {
+		return func(bot *Bot, update Update) error {
+			if update.CallbackQuery == nil || bot.callbackQueries == nil {
+				return next(bot, update)
+			}
+
+			id := update.CallbackQuery.ID
+			bot.callbackQueries.track(id)
+			defer bot.callbackQueries.forget(id)
+
+			done := make(chan error, 1)
+			go func() {
+				done <- next(bot, update)
+			}()
+
+			timer := time.NewTimer(timeout)
+			defer timer.Stop()
+
+			select {
+			case err := <-done:
+				return errors.Join(err, answerIfPending(bot, id))
+			case <-timer.C:
+				answerErr := answerIfPending(bot, id)
+				return errors.Join(<-done, answerErr)
+			}
+		}
+	}
+}
+
+// answerIfPending answers the callback query with an empty notification unless it has already been answered.
+func answerIfPending(bot *Bot, id string) error {
+	if !bot.callbackQueries.claim(id) {
+		return nil
+	}
+	return bot.AnswerCallbackQuery(AnswerCallbackQueryRequest{CallbackQueryID: id})
+}
+
+// callbackQueryTracker remembers which in-flight callback queries have already been answered.
+type callbackQueryTracker struct {
+	mu       sync.Mutex
+	answered map[string]bool
+}
+
+func newCallbackQueryTracker() *callbackQueryTracker {
+	return &callbackQueryTracker{answered: make(map[string]bool)}
+}
+
+// track starts tracking the callback query as unanswered.
+func (t *callbackQueryTracker) track(id string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.answered[id] = false
+}
+
+// forget stops tracking the callback query.
+func (t *callbackQueryTracker) forget(id string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.answered, id)
+}
+
+// claim marks a tracked callback query as answered and reports whether it was still pending.
+func (t *callbackQueryTracker) claim(id string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	answered, tracked := t.answered[id]
+	if !tracked || answered {
+		return false
+	}
+	t.answered[id] = true
+	return true
+}
+
+// markAnswered records that the callback query has been answered, if it is being tracked.
+func (t *callbackQueryTracker) markAnswered(id string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, tracked := t.answered[id]; tracked {
+		t.answered[id] = true
+	}
+}
@@ -0,0 +1,203 @@
+package telegram
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+// DefaultAwayMessageTemplate is the away message AwayResponder sends when no Template is set.
+// Its output is plain text, which is escaped for the responder's ParseMode before sending.
+var DefaultAwayMessageTemplate = template.Must(template.New("away").Parse(
+	"Thanks for your message! We're closed right now" +
+		"{{with .NextOpening}} and will be back on {{.Format \"Monday\"}} at {{.Format \"15:04\"}}{{end}}. " +
+		"We'll get back to you as soon as we can."))
+
+// AwayMessageData is the data an AwayResponder template is executed with.
+type AwayMessageData struct {
+	// The business message that is being answered.
+	Message Message
+
+	// The business connection the message was received through.
+	Connection BusinessConnection
+
+	// The time the business opens next, in the business's time zone, or nil if the opening hours contain no intervals.
+	NextOpening *time.Time
+
+	parseMode *string
+}
+
+var legacyMarkdownEscaper = strings.NewReplacer(markdownV2EscapePairs("_*`[")...)
+
+// Escape escapes s for the parse mode of the away message, so that a value such as a customer's name is sent as plain text,
+// e.g. {{.Escape .Message.Chat.FirstName}}. Without a parse mode, s is returned unchanged.
+func (d AwayMessageData) Escape(s string) string {
+	return escapeParseMode(d.parseMode, s)
+}
+
+// escapeParseMode escapes s so that it is sent as plain text with the given parse mode.
+func escapeParseMode(parseMode *string, s string) string {
+	switch stringValue(parseMode) {
+	case "HTML":
+		return htmlEscaper.Replace(s)
+	case "MarkdownV2":
+		return markdownV2Escaper.Replace(s)
+	case "Markdown":
+		return legacyMarkdownEscaper.Replace(s)
+	}
+	return s
+}
+
+// awayReplyKey identifies a customer chat of a business account.
+type awayReplyKey struct {
+	businessConnectionID string
+	chatID               int64
+}
+
+// AwayResponder automatically answers business messages received outside of the business's opening hours.
+//
+// Each customer is answered at most once per closed period: after an away message is sent, further messages from the same chat
+// are not answered until the business has opened again. Messages sent by the business account itself are ignored.
+type AwayResponder struct {
+	// (Required) Opening hours of the business. They are evaluated in the time zone they are defined for.
+	Hours BusinessOpeningHours
+
+	// (Optional) Template of the away message, executed with AwayMessageData. Defaults to DefaultAwayMessageTemplate.
+	// Values are inserted as they are: when ParseMode is set, the template must escape them, e.g. with AwayMessageData.Escape.
+	Template *template.Template
+
+	// (Optional) Mode for parsing entities in the away message text.
+	ParseMode *string
+
+	// (Optional) Returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	mu      sync.Mutex
+	replied map[awayReplyKey]time.Time
+}
+
+// NewAwayResponder creates a responder for a business with the given opening hours.
+func NewAwayResponder(hours BusinessOpeningHours) *AwayResponder {
+	return &AwayResponder{Hours: hours}
+}
+
+// Middleware returns a middleware that answers new business messages received while the business is closed.
+// The update is passed on to the next handler either way.
+func (a *AwayResponder) Middleware() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(bot *Bot, update Update) error {
+			var replyErr error
+			if update.BusinessMessage != nil {
+				replyErr = a.HandleBusinessMessage(bot, *update.BusinessMessage)
+			}
+			return errors.Join(replyErr, next(bot, update))
+		}
+	}
+}
+
+// HandleBusinessMessage sends the away message in reply to message if the business is closed and the customer
+// hasn't been answered during the current closed period yet.
+func (a *AwayResponder) HandleBusinessMessage(bot *Bot, message Message) error {
+	if message.BusinessConnectionID == nil {
+		return nil
+	}
+
+	now := time.Now()
+	if a.Now != nil {
+		now = a.Now()
+	}
+
+	open, err := a.Hours.IsOpen(now)
+	if err != nil || open {
+		return err
+	}
+
+	connection, err := bot.businessConnection(*message.BusinessConnectionID)
+	if err != nil {
+		return err
+	}
+	if message.From != nil && message.From.ID == connection.User.ID {
+		return nil
+	}
+
+	nextOpening, scheduled, err := a.Hours.NextOpening(now)
+	if err != nil {
+		return err
+	}
+
+	key := awayReplyKey{connection.ID, message.Chat.ID}
+	if !a.claim(key, now, nextOpening) {
+		return nil
+	}
+
+	data := AwayMessageData{Message: message, Connection: connection, parseMode: a.ParseMode}
+	if scheduled {
+		data.NextOpening = &nextOpening
+	}
+
+	tmpl := a.Template
+	if tmpl == nil {
+		tmpl = DefaultAwayMessageTemplate
+	}
+
+	text := new(bytes.Buffer)
+	if err := tmpl.Execute(text, data); err != nil {
+		a.release(key)
+		return fmt.Errorf("error executing away message template: %w", err)
+	}
+
+	body := text.String()
+	if a.Template == nil {
+		body = escapeParseMode(a.ParseMode, body)
+	}
+
+	_, err = bot.SendMessage(SendMessageRequest{
+		BusinessConnectionID: &connection.ID,
+		ChatID:               message.Chat.ID,
+		Text:                 body,
+		ParseMode:            a.ParseMode,
+	})
+	if errors.Is(err, ErrBusinessReplyNotAllowed) {
+		return nil
+	}
+	if err != nil {
+		a.release(key)
+		return err
+	}
+	return nil
+}
+
+// claim records that the chat is being answered in the closed period ending at nextOpening and reports whether
+// it hadn't been answered in that period before.
+func (a *AwayResponder) claim(key awayReplyKey, now, nextOpening time.Time) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.replied == nil {
+		a.replied = make(map[awayReplyKey]time.Time)
+	}
+
+	if period, ok := a.replied[key]; ok && period.Equal(nextOpening) {
+		return false
+	}
+
+	for k, period := range a.replied {
+		if !period.IsZero() && !period.After(now) {
+			delete(a.replied, k)
+		}
+	}
+
+	a.replied[key] = nextOpening
+	return true
+}
+
+// release forgets that the chat was answered, so that the next message is answered again.
+func (a *AwayResponder) release(key awayReplyKey) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.replied, key)
+}
@@ -0,0 +1,216 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultBoostLookupInterval is how long RequireBoost trusts a lookup that found a user not boosting, if LookupInterval isn't set.
+const DefaultBoostLookupInterval = 5 * time.Minute
+
+// BoostRegistry tracks the active boosts of users in the chats the bot administers, so that features can be gated
+// on boosting a chat.
+//
+// The registry is fed with chat_boost and removed_chat_boost updates, which must be listed in allowed_updates, and can be
+// refreshed for a single user with getUserChatBoosts. Boosts are considered active until their expiration date.
+type BoostRegistry struct {
+	// (Optional) How long RequireBoost waits before looking up the boosts of a user who wasn't boosting again.
+	// Boosts recorded from updates in the meantime are taken into account immediately. Defaults to DefaultBoostLookupInterval.
+	LookupInterval time.Duration
+
+	// (Optional) Returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	mu      sync.Mutex
+	boosts  map[int64]map[string]trackedBoost
+	lookups map[boostLookupKey]time.Time
+}
+
+// boostLookupKey identifies a user in a chat whose boosts were looked up.
+type boostLookupKey struct {
+	chatID int64
+	userID int64
+}
+
+// trackedBoost is a boost along with the user who added it.
+type trackedBoost struct {
+	userID int64
+	boost  ChatBoost
+}
+
+// NewBoostRegistry creates an empty registry.
+func NewBoostRegistry() *BoostRegistry {
+	return &BoostRegistry{boosts: make(map[int64]map[string]trackedBoost), lookups: make(map[boostLookupKey]time.Time)}
+}
+
+// Middleware returns a middleware that records boost updates before passing them on.
+func (r *BoostRegistry) Middleware() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(bot *Bot, update Update) error {
+			r.RecordUpdate(update)
+			return next(bot, update)
+		}
+	}
+}
+
+// RecordUpdate records the boost added, changed or removed by the update, if any.
+func (r *BoostRegistry) RecordUpdate(update Update) {
+	switch {
+	case update.ChatBoost != nil:
+		r.Add(update.ChatBoost.Chat.ID, update.ChatBoost.Boost)
+	case update.RemovedChatBoost != nil:
+		r.Remove(update.RemovedChatBoost.Chat.ID, update.RemovedChatBoost.BoostID)
+	}
+}
+
+// Add records a boost of the chat. Boosts without a user, such as unclaimed giveaway prizes, are ignored.
+func (r *BoostRegistry) Add(chatID int64, boost ChatBoost) {
+	user := boost.Source.User()
+	if user == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	chat, ok := r.boosts[chatID]
+	if !ok {
+		chat = make(map[string]trackedBoost)
+		r.boosts[chatID] = chat
+	}
+	chat[boost.BoostID] = trackedBoost{userID: user.ID, boost: boost}
+}
+
+// Remove forgets a boost of the chat.
+func (r *BoostRegistry) Remove(chatID int64, boostID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.boosts[chatID], boostID)
+}
+
+// Boosts returns the active boosts the user added to the chat.
+func (r *BoostRegistry) Boosts(chatID, userID int64) []ChatBoost {
+	now := r.now().Unix()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var boosts []ChatBoost
+	for id, tracked := range r.boosts[chatID] {
+		if tracked.boost.ExpirationDate <= now {
+			delete(r.boosts[chatID], id)
+			continue
+		}
+		if tracked.userID == userID {
+			boosts = append(boosts, tracked.boost)
+		}
+	}
+	return boosts
+}
+
+// IsBoosting reports whether the user has at least minBoosts active boosts in the chat.
+func (r *BoostRegistry) IsBoosting(chatID, userID int64, minBoosts int) bool {
+	return len(r.Boosts(chatID, userID)) >= max(minBoosts, 1)
+}
+
+// Refresh replaces the boosts the user added to the chat with the current list from getUserChatBoosts.
+// The bot must be an administrator in the chat.
+func (r *BoostRegistry) Refresh(bot *Bot, chatID, userID int64) error {
+	boosts, err := bot.GetUserChatBoosts(GetUserChatBoostsRequest{ChatID: chatID, UserID: userID})
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	chat, ok := r.boosts[chatID]
+	if !ok {
+		chat = make(map[string]trackedBoost)
+		r.boosts[chatID] = chat
+	}
+	for id, tracked := range chat {
+		if tracked.userID == userID {
+			delete(chat, id)
+		}
+	}
+	for _, boost := range boosts.Boosts {
+		chat[boost.BoostID] = trackedBoost{userID: userID, boost: boost}
+	}
+	r.mu.Unlock()
+
+	return nil
+}
+
+// RequireBoost returns a middleware that only passes on updates from users with at least minBoosts active boosts in the chat.
+// Users the registry doesn't know to be boosting are looked up with getUserChatBoosts, at most once per LookupInterval.
+// Other updates, and updates without a sender, are passed to denied instead; a nil denied drops them. If the lookup fails,
+// the update is denied as well and the error is returned along with denied's.
+func (r *BoostRegistry) RequireBoost(chatID int64, minBoosts int, denied HandlerFunc) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(bot *Bot, update Update) error {
+			var lookupErr error
+			user := update.Sender()
+			if user != nil && !r.IsBoosting(chatID, user.ID, minBoosts) && r.lookupDue(chatID, user.ID) {
+				if err := r.Refresh(bot, chatID, user.ID); err != nil {
+					lookupErr = fmt.Errorf("error looking up boosts of user %d: %w", user.ID, err)
+				} else {
+					r.recordLookup(chatID, user.ID)
+				}
+			}
+
+			if lookupErr == nil && user != nil && r.IsBoosting(chatID, user.ID, minBoosts) {
+				return next(bot, update)
+			}
+			if denied != nil {
+				return errors.Join(lookupErr, denied(bot, update))
+			}
+			return lookupErr
+		}
+	}
+}
+
+// lookupDue reports whether the user's boosts in the chat haven't been looked up within the lookup interval.
+func (r *BoostRegistry) lookupDue(chatID, userID int64) bool {
+	now := r.now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	last, ok := r.lookups[boostLookupKey{chatID, userID}]
+	return !ok || now.Sub(last) >= r.lookupInterval()
+}
+
+// recordLookup records that the user's boosts in the chat were just looked up, and forgets lookups that are due again.
+func (r *BoostRegistry) recordLookup(chatID, userID int64) {
+	now := r.now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.lookups == nil {
+		r.lookups = make(map[boostLookupKey]time.Time)
+	}
+	for key, last := range r.lookups {
+		if now.Sub(last) >= r.lookupInterval() {
+			delete(r.lookups, key)
+		}
+	}
+	r.lookups[boostLookupKey{chatID, userID}] = now
+}
+
+// lookupInterval returns LookupInterval or its default.
+func (r *BoostRegistry) lookupInterval() time.Duration {
+	if r.LookupInterval > 0 {
+		return r.LookupInterval
+	}
+	return DefaultBoostLookupInterval
+}
+
+// now returns the current time according to the registry's clock.
+func (r *BoostRegistry) now() time.Time {
+	if r.Now != nil {
+		return r.Now()
+	}
+	return time.Now()
+}
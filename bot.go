@@ -6,14 +6,18 @@ import (
 )
 
 type Bot struct {
-	Token  string
-	client *http.Client
+	Token               string
+	client              *http.Client
+	callbackQueries     *callbackQueryTracker
+	businessConnections *businessConnectionTracker
 }
 
 // Each bot is given a unique authentication token when it is created.
 func NewBot(token string) Bot {
 	return Bot{
-		Token:  strings.TrimSpace(token),
-		client: &http.Client{Timeout: 10},
+		Token:               strings.TrimSpace(token),
+		client:              &http.Client{Timeout: 10},
+		callbackQueries:     newCallbackQueryTracker(),
+		businessConnections: newBusinessConnectionTracker(),
 	}
 }
@@ -0,0 +1,183 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrBusinessReplyNotAllowed is returned by send methods when a reply to a business message would be sent on behalf of
+// a business account whose connection is disabled or doesn't allow the bot to reply.
+var ErrBusinessReplyNotAllowed = errors.New("business connection doesn't allow the bot to reply")
+
+// maxTrackedBusinessMessages bounds the number of business messages remembered for automatic replies.
+const maxTrackedBusinessMessages = 10000
+
+// businessMessageKey identifies a message in a chat of a business account.
+type businessMessageKey struct {
+	chatID    int64
+	messageID int
+}
+
+// businessConnectionTracker remembers business connections and the business messages the bot received, so that replies
+// to those messages can be sent on behalf of the right business account.
+type businessConnectionTracker struct {
+	mu          sync.Mutex
+	connections map[string]BusinessConnection
+	messages    map[businessMessageKey]string
+	order       []businessMessageKey
+}
+
+func newBusinessConnectionTracker() *businessConnectionTracker {
+	return &businessConnectionTracker{
+		connections: make(map[string]BusinessConnection),
+		messages:    make(map[businessMessageKey]string),
+	}
+}
+
+// observe records the business connection or business messages carried by the update.
+func (t *businessConnectionTracker) observe(update Update) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch {
+	case update.BusinessConnection != nil:
+		t.connections[update.BusinessConnection.ID] = *update.BusinessConnection
+	case update.BusinessMessage != nil:
+		t.addMessage(*update.BusinessMessage)
+	case update.EditedBusinessMessage != nil:
+		t.addMessage(*update.EditedBusinessMessage)
+	case update.DeletedBusinessMessages != nil:
+		t.removeMessages(update.DeletedBusinessMessages.Chat.ID, update.DeletedBusinessMessages.MessageIDs)
+	}
+}
+
+// removeMessages forgets deleted business messages, keeping the eviction order in sync with the remembered messages.
+func (t *businessConnectionTracker) removeMessages(chatID int64, messageIDs []int) {
+	removed := 0
+	for _, messageID := range messageIDs {
+		key := businessMessageKey{chatID, messageID}
+		if _, known := t.messages[key]; known {
+			delete(t.messages, key)
+			removed++
+		}
+	}
+	if removed == 0 {
+		return
+	}
+
+	order := t.order[:0]
+	for _, key := range t.order {
+		if _, known := t.messages[key]; known {
+			order = append(order, key)
+		}
+	}
+	clear(t.order[len(order):])
+	t.order = order
+}
+
+// addMessage remembers the business connection of the message, evicting the oldest messages beyond the limit.
+func (t *businessConnectionTracker) addMessage(message Message) {
+	if message.BusinessConnectionID == nil {
+		return
+	}
+
+	key := businessMessageKey{message.Chat.ID, message.MessageID}
+	if _, known := t.messages[key]; !known {
+		t.order = append(t.order, key)
+	}
+	t.messages[key] = *message.BusinessConnectionID
+
+	for len(t.order) > maxTrackedBusinessMessages {
+		delete(t.messages, t.order[0])
+		t.order = t.order[1:]
+	}
+}
+
+// connectionFor returns the business connection identifier of a received business message, if it is known.
+func (t *businessConnectionTracker) connectionFor(chatID int64, messageID int) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	id, ok := t.messages[businessMessageKey{chatID, messageID}]
+	return id, ok
+}
+
+// connection returns a known business connection.
+func (t *businessConnectionTracker) connection(id string) (BusinessConnection, bool) {
+	if t == nil {
+		return BusinessConnection{}, false
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	connection, ok := t.connections[id]
+	return connection, ok
+}
+
+// storeConnection caches a business connection fetched from Telegram.
+func (t *businessConnectionTracker) storeConnection(connection BusinessConnection) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.connections[connection.ID] = connection
+}
+
+// businessConnection returns the business connection with the given identifier, getting it from Telegram if it isn't known yet.
+func (b *Bot) businessConnection(id string) (BusinessConnection, error) {
+	if connection, ok := b.businessConnections.connection(id); ok {
+		return connection, nil
+	}
+
+	connection, err := b.GetBusinessConnection(GetBusinessConnectionRequest{BusinessConnectionID: id})
+	if err != nil {
+		return BusinessConnection{}, fmt.Errorf("error getting business connection %s: %w", id, err)
+	}
+	b.businessConnections.storeConnection(connection)
+	return connection, nil
+}
+
+// businessConnectionForReply returns the business connection a message should be sent on behalf of.
+//
+// An explicitly set businessConnectionID is kept. Otherwise, if the message replies to a business message in the same chat,
+// the connection of that message is used. Either way, the connection must be enabled and allow the bot to reply.
+func (b *Bot) businessConnectionForReply(businessConnectionID *string, chatID interface{}, reply *ReplyParameters) (*string, error) {
+	if businessConnectionID == nil {
+		if reply == nil || reply.ChatID != nil {
+			return nil, nil
+		}
+
+		var chat int64
+		switch id := chatID.(type) {
+		case int64:
+			chat = id
+		case int:
+			chat = int64(id)
+		default:
+			return nil, nil
+		}
+
+		id, ok := b.businessConnections.connectionFor(chat, reply.MessageID)
+		if !ok {
+			return nil, nil
+		}
+		businessConnectionID = &id
+	}
+
+	connection, err := b.businessConnection(*businessConnectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !connection.IsEnabled || !connection.CanReply {
+		return nil, fmt.Errorf("%w: connection %s", ErrBusinessReplyNotAllowed, connection.ID)
+	}
+
+	return businessConnectionID, nil
+}
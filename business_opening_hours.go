@@ -0,0 +1,84 @@
+package telegram
+
+import (
+	"fmt"
+	"time"
+)
+
+// minutesPerWeek is the length of the week opening hours intervals are expressed in.
+const minutesPerWeek = 7 * 24 * 60
+
+// BusinessOpeningHours describes the opening hours of a business.
+//
+// See "BusinessOpeningHours" https://core.telegram.org/bots/api#businessopeninghours
+type BusinessOpeningHours struct {
+	// (Required) Unique name of the time zone for which the opening hours are defined.
+	TimeZoneName string `json:"time_zone_name"`
+
+	// (Required) List of time intervals describing business opening hours.
+	OpeningHours []BusinessOpeningHoursInterval `json:"opening_hours"`
+}
+
+// IsOpen reports whether the business is open at t, evaluated in the business's time zone.
+func (h BusinessOpeningHours) IsOpen(t time.Time) (bool, error) {
+	local, err := h.localTime(t)
+	if err != nil {
+		return false, err
+	}
+
+	minute := minuteOfWeek(local)
+	for _, interval := range h.OpeningHours {
+		// Intervals may extend past the end of the week into the next Monday.
+		for _, m := range []int{minute, minute + minutesPerWeek} {
+			if m >= interval.OpeningMinute && m < interval.ClosingMinute {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// NextOpening returns the time the business next opens after t, or t itself if the business is open at t.
+// The second result is false if the opening hours contain no intervals.
+func (h BusinessOpeningHours) NextOpening(t time.Time) (time.Time, bool, error) {
+	open, err := h.IsOpen(t)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if open {
+		return t, true, nil
+	}
+	if len(h.OpeningHours) == 0 {
+		return time.Time{}, false, nil
+	}
+
+	local, _ := h.localTime(t)
+	minute := minuteOfWeek(local)
+
+	wait := minutesPerWeek
+	for _, interval := range h.OpeningHours {
+		delta := ((interval.OpeningMinute-minute)%minutesPerWeek + minutesPerWeek) % minutesPerWeek
+		if delta == 0 {
+			delta = minutesPerWeek
+		}
+		wait = min(wait, delta)
+	}
+
+	next := time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), local.Minute()+wait, 0, 0, local.Location())
+	return next, true, nil
+}
+
+// localTime converts t to the business's time zone.
+func (h BusinessOpeningHours) localTime(t time.Time) (time.Time, error) {
+	location, err := time.LoadLocation(h.TimeZoneName)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error loading time zone %q: %w", h.TimeZoneName, err)
+	}
+	return t.In(location), nil
+}
+
+// minuteOfWeek returns the minute's sequence number in the week of t, starting on Monday.
+func minuteOfWeek(t time.Time) int {
+	day := (int(t.Weekday()) + 6) % 7
+	return day*24*60 + t.Hour()*60 + t.Minute()
+}
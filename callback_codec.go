@@ -0,0 +1,241 @@
+package telegram
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const (
+	// maxCallbackDataLength is the maximum size of callback_data accepted by Telegram, in bytes.
+	maxCallbackDataLength = 64
+
+	// callbackTagSize is the number of HMAC-SHA256 bytes kept in the signature tag.
+	callbackTagSize = 6
+
+	// callbackStoreIDSize is the number of random bytes in the ID of a payload kept in a CallbackDataStore.
+	callbackStoreIDSize = 6
+
+	// callbackStoreMarker prefixes the body of callback data whose payload lives in a CallbackDataStore.
+	callbackStoreMarker = "#"
+
+	// DefaultCallbackDataTTL is how long payloads kept in a CallbackDataStore remain valid unless configured otherwise.
+	DefaultCallbackDataTTL = 7 * 24 * time.Hour
+)
+
+// ErrCallbackDataInvalid is returned when callback data is malformed or its signature doesn't match.
+var ErrCallbackDataInvalid = errors.New("callback data is malformed or has an invalid signature")
+
+// CallbackCodec packs small structs into signed callback_data strings and back.
+//
+// The encoded form is "route:body.tag", where body is a JSON array holding the exported fields of the struct
+// in declaration order, and tag is a truncated HMAC-SHA256 over "route:body" keyed with the codec's secret.
+// Users therefore cannot forge or alter callback data without the secret.
+//
+// If the result would exceed the 64 bytes allowed by Telegram, the body is kept in the store under a short random ID
+// and only "#id" is sent in its place. Such payloads expire after TTL.
+type CallbackCodec struct {
+	secret []byte
+	store  CallbackDataStore
+
+	// TTL is how long payloads kept in the store remain valid. Defaults to DefaultCallbackDataTTL.
+	TTL time.Duration
+}
+
+// NewCallbackCodec creates a codec that signs callback data with secret. If store is nil, payloads that don't fit
+// into callback_data cannot be encoded.
+func NewCallbackCodec(secret []byte, store CallbackDataStore) *CallbackCodec {
+	return &CallbackCodec{
+		secret: append([]byte(nil), secret...),
+		store:  store,
+		TTL:    DefaultCallbackDataTTL,
+	}
+}
+
+// Encode serializes the exported fields of payload, which must be a struct or a pointer to one, into callback data
+// for the given route. The route must not be empty or contain ':'.
+func (c *CallbackCodec) Encode(route string, payload any) (string, error) {
+	if route == "" || strings.Contains(route, ":") {
+		return "", fmt.Errorf("invalid callback route %q", route)
+	}
+
+	body, err := marshalCallbackPayload(payload)
+	if err != nil {
+		return "", err
+	}
+
+	data := c.sign(route + ":" + string(body))
+	if len(data) <= maxCallbackDataLength {
+		return data, nil
+	}
+
+	if c.store == nil {
+		return "", fmt.Errorf("callback data for route %q is %d bytes long and no store is configured", route, len(data))
+	}
+
+	id, err := newCallbackStoreID()
+	if err != nil {
+		return "", err
+	}
+
+	ttl := c.TTL
+	if ttl <= 0 {
+		ttl = DefaultCallbackDataTTL
+	}
+	if err := c.store.Put(id, body, time.Now().Add(ttl)); err != nil {
+		return "", fmt.Errorf("error storing callback payload: %w", err)
+	}
+
+	data = c.sign(route + ":" + callbackStoreMarker + id)
+	if len(data) > maxCallbackDataLength {
+		return "", fmt.Errorf("callback route %q is too long", route)
+	}
+	return data, nil
+}
+
+// Decode verifies data and fills payload, which must be a pointer to a struct, from it.
+// It returns the route the data was encoded for.
+func (c *CallbackCodec) Decode(data string, payload any) (string, error) {
+	signed, tag, ok := cutLast(data, ".")
+	if !ok {
+		return "", ErrCallbackDataInvalid
+	}
+
+	expected, err := base64.RawURLEncoding.DecodeString(tag)
+	if err != nil || !hmac.Equal(expected, c.mac(signed)) {
+		return "", ErrCallbackDataInvalid
+	}
+
+	route, body, ok := strings.Cut(signed, ":")
+	if !ok {
+		return "", ErrCallbackDataInvalid
+	}
+
+	raw := []byte(body)
+	if id, stored := strings.CutPrefix(body, callbackStoreMarker); stored {
+		if c.store == nil {
+			return "", fmt.Errorf("callback data for route %q refers to a stored payload and no store is configured", route)
+		}
+		if raw, err = c.store.Get(id); err != nil {
+			return "", fmt.Errorf("error loading callback payload %s: %w", id, err)
+		}
+	}
+
+	if err := unmarshalCallbackPayload(raw, payload); err != nil {
+		return "", err
+	}
+	return route, nil
+}
+
+// Matches reports whether data was encoded for route. It doesn't verify the signature.
+func (c *CallbackCodec) Matches(route, data string) bool {
+	return strings.HasPrefix(data, route+":")
+}
+
+// sign appends the signature tag to s.
+func (c *CallbackCodec) sign(s string) string {
+	return s + "." + base64.RawURLEncoding.EncodeToString(c.mac(s))
+}
+
+// mac returns the truncated HMAC-SHA256 of s.
+func (c *CallbackCodec) mac(s string) []byte {
+	h := hmac.New(sha256.New, c.secret)
+	h.Write([]byte(s))
+	return h.Sum(nil)[:callbackTagSize]
+}
+
+// HandleCallback registers handler on router for callback queries encoded by codec for route.
+// The handler receives the callback query together with the decoded payload. Callback data that fails verification
+// is not passed to the handler; the error is returned from the router instead.
+func HandleCallback[T any](router *Router, codec *CallbackCodec, route string, handler func(bot *Bot, query CallbackQuery, payload T) error) {
+	router.Handle(UpdateTypeCallbackQuery, func(update Update) bool {
+		data := update.CallbackQuery.Data
+		return data != nil && codec.Matches(route, *data)
+	}, func(bot *Bot, update Update) error {
+		var payload T
+		if _, err := codec.Decode(*update.CallbackQuery.Data, &payload); err != nil {
+			return fmt.Errorf("error decoding callback data for route %q: %w", route, err)
+		}
+		return handler(bot, *update.CallbackQuery, payload)
+	})
+}
+
+// marshalCallbackPayload encodes the exported fields of a struct as a JSON array.
+func marshalCallbackPayload(payload any) ([]byte, error) {
+	value := reflect.ValueOf(payload)
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("callback payload must be a struct, got %T", payload)
+	}
+
+	var fields []any
+	for i := 0; i < value.NumField(); i++ {
+		if value.Type().Field(i).IsExported() {
+			fields = append(fields, value.Field(i).Interface())
+		}
+	}
+
+	body, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding callback payload: %w", err)
+	}
+	return body, nil
+}
+
+// unmarshalCallbackPayload decodes a JSON array produced by marshalCallbackPayload into the struct payload points to.
+func unmarshalCallbackPayload(body []byte, payload any) error {
+	value := reflect.ValueOf(payload)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("callback payload must be a non-nil pointer to a struct, got %T", payload)
+	}
+	value = value.Elem()
+
+	var fields []json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return fmt.Errorf("error decoding callback payload: %w", err)
+	}
+
+	next := 0
+	for i := 0; i < value.NumField(); i++ {
+		if !value.Type().Field(i).IsExported() {
+			continue
+		}
+		if next >= len(fields) {
+			return fmt.Errorf("callback payload has %d fields, %s expects more", len(fields), value.Type())
+		}
+		if err := json.Unmarshal(fields[next], value.Field(i).Addr().Interface()); err != nil {
+			return fmt.Errorf("error decoding callback payload field %s: %w", value.Type().Field(i).Name, err)
+		}
+		next++
+	}
+	if next != len(fields) {
+		return fmt.Errorf("callback payload has %d fields, %s expects %d", len(fields), value.Type(), next)
+	}
+	return nil
+}
+
+// newCallbackStoreID returns a random URL-safe identifier for a stored payload.
+func newCallbackStoreID() (string, error) {
+	id := make([]byte, callbackStoreIDSize)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("error generating callback payload ID: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(id), nil
+}
+
+// cutLast slices s around the last instance of sep.
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
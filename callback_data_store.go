@@ -0,0 +1,65 @@
+package telegram
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCallbackDataNotFound is returned by a CallbackDataStore when no unexpired payload is stored under the requested ID.
+var ErrCallbackDataNotFound = errors.New("callback data not found or expired")
+
+// CallbackDataStore keeps callback payloads that don't fit into the 64 bytes of callback_data.
+// Implementations must be safe for concurrent use.
+type CallbackDataStore interface {
+	// Put stores data under id until expiresAt.
+	Put(id string, data []byte, expiresAt time.Time) error
+
+	// Get returns the data stored under id, or ErrCallbackDataNotFound if there is none or it has expired.
+	Get(id string) ([]byte, error)
+}
+
+// MemoryCallbackDataStore is an in-process CallbackDataStore. Payloads are lost when the process exits,
+// so buttons created before a restart stop working.
+type MemoryCallbackDataStore struct {
+	mu      sync.Mutex
+	entries map[string]memoryCallbackDataEntry
+}
+
+type memoryCallbackDataEntry struct {
+	data      []byte
+	expiresAt time.Time
+}
+
+// NewMemoryCallbackDataStore creates an empty in-memory store.
+func NewMemoryCallbackDataStore() *MemoryCallbackDataStore {
+	return &MemoryCallbackDataStore{entries: make(map[string]memoryCallbackDataEntry)}
+}
+
+// Put stores data under id until expiresAt. Expired entries are swept on every call.
+func (s *MemoryCallbackDataStore) Put(id string, data []byte, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for key, entry := range s.entries {
+		if !entry.expiresAt.After(now) {
+			delete(s.entries, key)
+		}
+	}
+
+	s.entries[id] = memoryCallbackDataEntry{data: append([]byte(nil), data...), expiresAt: expiresAt}
+	return nil
+}
+
+// Get returns the data stored under id.
+func (s *MemoryCallbackDataStore) Get(id string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[id]
+	if !ok || !entry.expiresAt.After(time.Now()) {
+		return nil, ErrCallbackDataNotFound
+	}
+	return append([]byte(nil), entry.data...), nil
+}
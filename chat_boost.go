@@ -0,0 +1,19 @@
+package telegram
+
+// ChatBoost contains information about a chat boost.
+//
+// See "ChatBoost" https://core.telegram.org/bots/api#chatboost
+type ChatBoost struct {
+	// (Required) Unique identifier of the boost.
+	BoostID string `json:"boost_id"`
+
+	// (Required) Point in time (Unix timestamp) when the chat was boosted.
+	AddDate int64 `json:"add_date"`
+
+	// (Required) Point in time (Unix timestamp) when the boost will automatically expire, unless the booster's Telegram Premium
+	// subscription is prolonged.
+	ExpirationDate int64 `json:"expiration_date"`
+
+	// (Required) Source of the added boost.
+	Source ChatBoostSource `json:"source"`
+}
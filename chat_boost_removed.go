@@ -0,0 +1,18 @@
+package telegram
+
+// ChatBoostRemoved represents a boost removed from a chat.
+//
+// See "ChatBoostRemoved" https://core.telegram.org/bots/api#chatboostremoved
+type ChatBoostRemoved struct {
+	// (Required) Chat which was boosted.
+	Chat Chat `json:"chat"`
+
+	// (Required) Unique identifier of the boost.
+	BoostID string `json:"boost_id"`
+
+	// (Required) Point in time (Unix timestamp) when the boost was removed.
+	RemoveDate int64 `json:"remove_date"`
+
+	// (Required) Source of the removed boost.
+	Source ChatBoostSource `json:"source"`
+}
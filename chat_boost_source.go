@@ -0,0 +1,81 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ChatBoostSource describes the source of a chat boost. It can be one of ChatBoostSourcePremium, ChatBoostSourceGiftCode
+// or ChatBoostSourceGiveaway; exactly one of the fields is set after decoding. Sources this package doesn't know are decoded
+// into Unknown.
+//
+// See "ChatBoostSource" https://core.telegram.org/bots/api#chatboostsource
+type ChatBoostSource struct {
+	Premium  *ChatBoostSourcePremium
+	GiftCode *ChatBoostSourceGiftCode
+	Giveaway *ChatBoostSourceGiveaway
+	Unknown  *ChatBoostSourceUnknown
+}
+
+// UnmarshalJSON decodes the variant named by the source field.
+func (s *ChatBoostSource) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Source string `json:"source"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return fmt.Errorf("error decoding chat boost source: %w", err)
+	}
+
+	*s = ChatBoostSource{}
+	switch probe.Source {
+	case "premium":
+		s.Premium = new(ChatBoostSourcePremium)
+		return json.Unmarshal(data, s.Premium)
+	case "gift_code":
+		s.GiftCode = new(ChatBoostSourceGiftCode)
+		return json.Unmarshal(data, s.GiftCode)
+	case "giveaway":
+		s.Giveaway = new(ChatBoostSourceGiveaway)
+		return json.Unmarshal(data, s.Giveaway)
+	default:
+		s.Unknown = &ChatBoostSourceUnknown{Raw: rawVariant(data)}
+		if err := json.Unmarshal(data, s.Unknown); err != nil {
+			// The user field is only decoded on a best-effort basis.
+			s.Unknown.User = nil
+		}
+		s.Unknown.Source = probe.Source
+		return nil
+	}
+}
+
+// MarshalJSON encodes whichever variant is set.
+func (s ChatBoostSource) MarshalJSON() ([]byte, error) {
+	switch {
+	case s.Premium != nil:
+		return json.Marshal(s.Premium)
+	case s.GiftCode != nil:
+		return json.Marshal(s.GiftCode)
+	case s.Giveaway != nil:
+		return json.Marshal(s.Giveaway)
+	case s.Unknown != nil:
+		return json.Marshal(s.Unknown)
+	default:
+		return nil, fmt.Errorf("chat boost source has no variant set")
+	}
+}
+
+// User returns the user that boosted the chat, or nil for unclaimed giveaway prizes.
+func (s ChatBoostSource) User() *User {
+	switch {
+	case s.Premium != nil:
+		return &s.Premium.User
+	case s.GiftCode != nil:
+		return &s.GiftCode.User
+	case s.Giveaway != nil:
+		return s.Giveaway.User
+	case s.Unknown != nil:
+		return s.Unknown.User
+	default:
+		return nil
+	}
+}
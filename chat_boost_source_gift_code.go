@@ -0,0 +1,13 @@
+package telegram
+
+// ChatBoostSourceGiftCode represents a boost obtained by the creation of Telegram Premium gift codes to boost a chat.
+// Each such code boosts the chat 4 times for the duration of the corresponding Telegram Premium subscription.
+//
+// See "ChatBoostSourceGiftCode" https://core.telegram.org/bots/api#chatboostsourcegiftcode
+type ChatBoostSourceGiftCode struct {
+	// (Required) Source of the boost, always “gift_code”.
+	Source string `json:"source"`
+
+	// (Required) User for which the gift code was created.
+	User User `json:"user"`
+}
@@ -0,0 +1,22 @@
+package telegram
+
+import "encoding/json"
+
+// ChatBoostSourceUnknown represents a chat boost source that isn't described by this package.
+type ChatBoostSourceUnknown struct {
+	// (Required) Source of the boost.
+	Source string `json:"source"`
+
+	// (Optional) User that boosted the chat, if the object has a user field of the usual form.
+	User *User `json:"user,omitempty"`
+
+	// (Required) The chat boost source object as received.
+	Raw json.RawMessage `json:"-"`
+}
+
+// MarshalJSON encodes Raw, or only the source if Raw is empty.
+func (s ChatBoostSourceUnknown) MarshalJSON() ([]byte, error) {
+	return marshalRawVariant(s.Raw, struct {
+		Source string `json:"source"`
+	}{s.Source})
+}
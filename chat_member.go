@@ -0,0 +1,105 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ChatMember contains information about one member of a chat. It can be one of ChatMemberOwner, ChatMemberAdministrator,
+// ChatMemberMember, ChatMemberRestricted, ChatMemberLeft or ChatMemberBanned; exactly one of the fields is set after decoding.
+// Members with a status this package doesn't know are decoded into Unknown.
+//
+// See "ChatMember" https://core.telegram.org/bots/api#chatmember
+type ChatMember struct {
+	Owner         *ChatMemberOwner
+	Administrator *ChatMemberAdministrator
+	Member        *ChatMemberMember
+	Restricted    *ChatMemberRestricted
+	Left          *ChatMemberLeft
+	Banned        *ChatMemberBanned
+	Unknown       *ChatMemberUnknown
+}
+
+// UnmarshalJSON decodes the variant named by the status field.
+func (m *ChatMember) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return fmt.Errorf("error decoding chat member: %w", err)
+	}
+
+	*m = ChatMember{}
+	switch probe.Status {
+	case "creator":
+		m.Owner = new(ChatMemberOwner)
+		return json.Unmarshal(data, m.Owner)
+	case "administrator":
+		m.Administrator = new(ChatMemberAdministrator)
+		return json.Unmarshal(data, m.Administrator)
+	case "member":
+		m.Member = new(ChatMemberMember)
+		return json.Unmarshal(data, m.Member)
+	case "restricted":
+		m.Restricted = new(ChatMemberRestricted)
+		return json.Unmarshal(data, m.Restricted)
+	case "left":
+		m.Left = new(ChatMemberLeft)
+		return json.Unmarshal(data, m.Left)
+	case "kicked":
+		m.Banned = new(ChatMemberBanned)
+		return json.Unmarshal(data, m.Banned)
+	default:
+		m.Unknown = &ChatMemberUnknown{Raw: rawVariant(data)}
+		if err := json.Unmarshal(data, m.Unknown); err != nil {
+			// The user field is only decoded on a best-effort basis.
+			m.Unknown.User = User{}
+		}
+		m.Unknown.Status = probe.Status
+		return nil
+	}
+}
+
+// MarshalJSON encodes whichever variant is set.
+func (m ChatMember) MarshalJSON() ([]byte, error) {
+	switch {
+	case m.Owner != nil:
+		return json.Marshal(m.Owner)
+	case m.Administrator != nil:
+		return json.Marshal(m.Administrator)
+	case m.Member != nil:
+		return json.Marshal(m.Member)
+	case m.Restricted != nil:
+		return json.Marshal(m.Restricted)
+	case m.Left != nil:
+		return json.Marshal(m.Left)
+	case m.Banned != nil:
+		return json.Marshal(m.Banned)
+	case m.Unknown != nil:
+		return json.Marshal(m.Unknown)
+	default:
+		return nil, fmt.Errorf("chat member has no variant set")
+	}
+}
+
+// User returns information about the chat member.
+func (m ChatMember) User() User {
+	switch {
+	case m.Owner != nil:
+		return m.Owner.User
+	case m.Administrator != nil:
+		return m.Administrator.User
+	case m.Member != nil:
+		return m.Member.User
+	case m.Restricted != nil:
+		return m.Restricted.User
+	case m.Left != nil:
+		return m.Left.User
+	case m.Banned != nil:
+		return m.Banned.User
+	case m.Unknown != nil:
+		return m.Unknown.User
+	default:
+		return User{}
+	}
+}
@@ -0,0 +1,23 @@
+package telegram
+
+import "encoding/json"
+
+// ChatMemberUnknown represents a chat member with a status that isn't described by this package.
+type ChatMemberUnknown struct {
+	// (Required) The member's status in the chat.
+	Status string `json:"status"`
+
+	// (Optional) Information about the user. Empty if the object has no user field of the usual form.
+	User User `json:"user"`
+
+	// (Required) The chat member object as received.
+	Raw json.RawMessage `json:"-"`
+}
+
+// MarshalJSON encodes Raw, or only the status and user if Raw is empty.
+func (m ChatMemberUnknown) MarshalJSON() ([]byte, error) {
+	return marshalRawVariant(m.Raw, struct {
+		Status string `json:"status"`
+		User   User   `json:"user"`
+	}{m.Status, m.User})
+}
@@ -0,0 +1,165 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultCheckoutTimeout is how long a CheckoutHandler waits for its callbacks unless configured otherwise.
+	// Telegram cancels the order if a pre-checkout query isn't answered within 10 seconds, so the default leaves time for the answer itself.
+	DefaultCheckoutTimeout = 7 * time.Second
+
+	// DefaultCheckoutErrorMessage is shown to the user when an order is rejected for a reason that isn't a CheckoutError.
+	DefaultCheckoutErrorMessage = "Sorry, we couldn't process your order. Please try again later."
+)
+
+// CheckoutError rejects an order with a message that is shown to the user.
+type CheckoutError struct {
+	// Message shown to the user by Telegram, e.g. “Sorry, delivery to your desired address is unavailable”.
+	Message string
+}
+
+func (e *CheckoutError) Error() string {
+	return e.Message
+}
+
+// CheckoutHandler answers shipping and pre-checkout queries on behalf of the bot.
+//
+// Every query is answered exactly once: with the result of the corresponding callback if it returns within Timeout,
+// or with a rejection otherwise. Errors of type *CheckoutError are shown to the user as is; any other error rejects the order
+// with DefaultCheckoutErrorMessage and is returned from the handler.
+type CheckoutHandler struct {
+	// (Required) Validate checks the order before the payment is made, typically by decoding the invoice payload and
+	// confirming the goods are still available at the quoted price. Returning nil accepts the order.
+	Validate func(bot *Bot, query PreCheckoutQuery) error
+
+	// (Optional) ShippingOptions returns the shipping options available for the address of a flexible invoice.
+	// Shipping queries are rejected if it isn't set.
+	ShippingOptions func(bot *Bot, query ShippingQuery) ([]ShippingOption, error)
+
+	// (Optional) Timeout for the callbacks. Defaults to DefaultCheckoutTimeout.
+	Timeout time.Duration
+}
+
+// Register adds the handler's shipping and pre-checkout query handlers to router.
+func (h *CheckoutHandler) Register(router *Router) {
+	router.Handle(UpdateTypeShippingQuery, nil, h.HandleShippingQuery)
+	router.Handle(UpdateTypePreCheckoutQuery, nil, h.HandlePreCheckoutQuery)
+}
+
+// HandlePreCheckoutQuery validates the order in update.PreCheckoutQuery and answers the query.
+func (h *CheckoutHandler) HandlePreCheckoutQuery(bot *Bot, update Update) error {
+	query := update.PreCheckoutQuery
+	if query == nil {
+		return nil
+	}
+
+	var err error
+	if query.InvoicePayload == "" {
+		err = errors.New("pre-checkout query has an empty invoice payload")
+	} else if h.Validate == nil {
+		err = errors.New("checkout handler has no Validate function")
+	} else {
+		_, err = callWithin(h.timeout(), func() (struct{}, error) {
+			return struct{}{}, h.Validate(bot, *query)
+		})
+	}
+
+	request := AnswerPreCheckoutQueryRequest{PreCheckoutQueryID: query.ID, Ok: err == nil}
+	if err != nil {
+		message := checkoutErrorMessage(err)
+		request.ErrorMessage = &message
+	}
+
+	if answerErr := bot.AnswerPreCheckoutQuery(request); answerErr != nil {
+		return errors.Join(checkoutInternalError(err), answerErr)
+	}
+	return checkoutInternalError(err)
+}
+
+// HandleShippingQuery looks up shipping options for update.ShippingQuery and answers the query.
+func (h *CheckoutHandler) HandleShippingQuery(bot *Bot, update Update) error {
+	query := update.ShippingQuery
+	if query == nil {
+		return nil
+	}
+
+	var options []ShippingOption
+	var err error
+	if h.ShippingOptions == nil {
+		err = &CheckoutError{Message: "Sorry, delivery to your address is unavailable."}
+	} else {
+		options, err = callWithin(h.timeout(), func() ([]ShippingOption, error) {
+			return h.ShippingOptions(bot, *query)
+		})
+		if err == nil && len(options) == 0 {
+			err = &CheckoutError{Message: "Sorry, delivery to your address is unavailable."}
+		}
+	}
+
+	request := AnswerShippingQueryRequest{ShippingQueryID: query.ID, Ok: err == nil}
+	if err != nil {
+		message := checkoutErrorMessage(err)
+		request.ErrorMessage = &message
+	} else {
+		request.ShippingOptions = options
+	}
+
+	if answerErr := bot.AnswerShippingQuery(request); answerErr != nil {
+		return errors.Join(checkoutInternalError(err), answerErr)
+	}
+	return checkoutInternalError(err)
+}
+
+func (h *CheckoutHandler) timeout() time.Duration {
+	if h.Timeout <= 0 {
+		return DefaultCheckoutTimeout
+	}
+	return h.Timeout
+}
+
+// callWithin runs f and returns its result, or an error if f doesn't return within timeout.
+// f keeps running in the background after a timeout; its result is discarded.
+func callWithin[T any](timeout time.Duration, f func() (T, error)) (T, error) {
+	type result struct {
+		value T
+		err   error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		value, err := f()
+		done <- result{value, err}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-timer.C:
+		var zero T
+		return zero, fmt.Errorf("checkout callback did not return within %s", timeout)
+	}
+}
+
+// checkoutErrorMessage returns the message shown to the user for err.
+func checkoutErrorMessage(err error) string {
+	var checkoutErr *CheckoutError
+	if errors.As(err, &checkoutErr) && checkoutErr.Message != "" {
+		return checkoutErr.Message
+	}
+	return DefaultCheckoutErrorMessage
+}
+
+// checkoutInternalError returns err unless it is a CheckoutError, which is an expected outcome rather than a failure.
+func checkoutInternalError(err error) error {
+	var checkoutErr *CheckoutError
+	if errors.As(err, &checkoutErr) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,398 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrInvalidTransition is returned when a conversation handler transitions to an undefined state, or to a state
+// that isn't among the transitions declared for the current one.
+var ErrInvalidTransition = errors.New("invalid conversation transition")
+
+// maxConversationTransitions bounds the transitions made while handling one update, so that OnEnter handlers
+// transitioning in a cycle can't loop forever.
+const maxConversationTransitions = 32
+
+// ConversationHandlerFunc handles an update that belongs to a conversation.
+type ConversationHandlerFunc func(c *ConversationContext) error
+
+// Conversation is a multi-step dialogue with a user, modeled as a finite state machine.
+//
+// A conversation is started by an entry point, whose handler transitions to the first state. While a conversation is active,
+// every update from the same user in the same chat and forum topic is passed to the handler of the current state, which may
+// transition to another state or end the conversation. Updates from users without an active conversation that match no entry
+// point are left to the router's other routes.
+//
+// State is kept in a ConversationStorage under the ConversationKey of the update, so it can survive restarts. Timeouts are
+// evaluated when the next update of a conversation arrives.
+type Conversation struct {
+	// (Required) Storage the state of active conversations is kept in.
+	Storage ConversationStorage
+
+	// (Optional) Time of inactivity after which a conversation times out, unless the current state sets its own timeout.
+	// Conversations don't time out if it is zero. A timed-out conversation is ended when the next update of the conversation
+	// arrives or when Sweep is called, whichever comes first.
+	Timeout time.Duration
+
+	// (Optional) Called with the state a conversation was in when it timed out. The update that revealed the timeout
+	// is handled afterwards as if there was no active conversation. When called from Sweep, the context's Update is empty.
+	OnTimeout ConversationHandlerFunc
+
+	// (Optional) Bot commands that end an active conversation, without the leading slash. NewConversation sets it to "cancel".
+	CancelCommands []string
+
+	// (Optional) Username of the bot, without the leading “@”. Entry and cancel commands addressed to another bot, as in
+	// “/cancel@other_bot”, are ignored. If empty, commands are accepted whichever bot they are addressed to.
+	BotUsername string
+
+	// (Optional) Called with the state a conversation was in when it was canceled, e.g. to confirm the cancellation.
+	OnCancel ConversationHandlerFunc
+
+	// (Optional) If true, entry points restart a conversation that is already active. They are ignored otherwise.
+	AllowReentry bool
+
+	// (Optional) Update types Register routes to the conversation. NewConversation sets it to messages and callback queries.
+	UpdateTypes []UpdateType
+
+	// (Optional) Returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	entries []conversationEntry
+	steps   map[string]*ConversationStep
+
+	mu    sync.Mutex
+	locks map[ConversationKey]*conversationLock
+}
+
+// conversationEntry is an entry point of a conversation.
+type conversationEntry struct {
+	match   func(update Update) bool
+	handler ConversationHandlerFunc
+}
+
+// conversationLock serializes the handling of updates of one conversation.
+type conversationLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// ConversationStep is a named state of a conversation, as returned by Conversation.State.
+type ConversationStep struct {
+	name        string
+	handler     ConversationHandlerFunc
+	onEnter     ConversationHandlerFunc
+	transitions []string
+	timeout     time.Duration
+}
+
+// NewConversation creates a conversation without states keeping its state in storage.
+func NewConversation(storage ConversationStorage) *Conversation {
+	return &Conversation{
+		Storage:        storage,
+		CancelCommands: []string{"cancel"},
+		UpdateTypes:    []UpdateType{UpdateTypeMessage, UpdateTypeCallbackQuery},
+	}
+}
+
+// Entry adds an entry point: updates for which match returns true start the conversation. The handler starts it by
+// transitioning to a state; if it doesn't, no conversation is started.
+func (c *Conversation) Entry(match func(update Update) bool, handler ConversationHandlerFunc) {
+	c.entries = append(c.entries, conversationEntry{match: match, handler: handler})
+}
+
+// EntryCommand adds an entry point for messages starting with the bot command, given without the leading slash.
+func (c *Conversation) EntryCommand(command string, handler ConversationHandlerFunc) {
+	c.Entry(func(update Update) bool {
+		return c.command(update) == command
+	}, handler)
+}
+
+// command returns the bot command the update's message starts with, or an empty string if there is none or it is
+// addressed to a bot other than BotUsername.
+func (c *Conversation) command(update Update) string {
+	if update.Message == nil {
+		return ""
+	}
+	if target := update.Message.CommandTarget(); target != "" && c.BotUsername != "" && !strings.EqualFold(target, c.BotUsername) {
+		return ""
+	}
+	return update.Message.Command()
+}
+
+// State defines a named state whose handler receives the updates of conversations in that state. Defining a state
+// again replaces it.
+func (c *Conversation) State(name string, handler ConversationHandlerFunc) *ConversationStep {
+	if c.steps == nil {
+		c.steps = make(map[string]*ConversationStep)
+	}
+	step := &ConversationStep{name: name, handler: handler}
+	c.steps[name] = step
+	return step
+}
+
+// To declares the states the state can transition to. A state without declared transitions can transition to any state.
+func (s *ConversationStep) To(states ...string) *ConversationStep {
+	s.transitions = append(s.transitions, states...)
+	return s
+}
+
+// Timeout sets the time of inactivity after which a conversation in this state times out, overriding Conversation.Timeout.
+func (s *ConversationStep) Timeout(timeout time.Duration) *ConversationStep {
+	s.timeout = timeout
+	return s
+}
+
+// OnEnter sets a handler called when a conversation transitions into this state, e.g. to ask the question the state expects
+// an answer to. It may transition again or end the conversation.
+func (s *ConversationStep) OnEnter(handler ConversationHandlerFunc) *ConversationStep {
+	s.onEnter = handler
+	return s
+}
+
+// Name returns the name of the state.
+func (s *ConversationStep) Name() string {
+	return s.name
+}
+
+// Register routes the conversation's update types to the conversation. Routes are tried in the order they were registered,
+// so registering the conversation before other routes lets it take precedence over them while it is active. Updates the
+// conversation doesn't handle, such as the first update after a timeout that matches no entry point, are passed on to the
+// following routes.
+func (c *Conversation) Register(router *Router) {
+	for _, updateType := range c.UpdateTypes {
+		router.handleMaybe(updateType, c.claims, c.HandleUpdate)
+	}
+}
+
+// claims reports whether the update belongs to an active conversation or matches an entry point.
+func (c *Conversation) claims(update Update) bool {
+	key, ok := ConversationKeyFor(update)
+	if !ok {
+		return false
+	}
+	if _, err := c.Storage.Get(key); !errors.Is(err, ErrConversationNotFound) {
+		return true
+	}
+	return c.entryFor(update) != nil
+}
+
+// HandleUpdate passes the update to the conversation. It reports whether the update was handled, i.e. whether it belonged
+// to an active conversation or matched an entry point.
+func (c *Conversation) HandleUpdate(bot *Bot, update Update) (bool, error) {
+	key, ok := ConversationKeyFor(update)
+	if !ok {
+		return false, nil
+	}
+
+	unlock := c.lock(key)
+	defer unlock()
+
+	record, err := c.Storage.Get(key)
+	active := err == nil
+	if err != nil && !errors.Is(err, ErrConversationNotFound) {
+		return true, err
+	}
+
+	var timeoutErr error
+	if active && c.expired(record) {
+		active = false
+		timeoutErr = c.timeOut(bot, update, key, record)
+	}
+
+	if active {
+		if command := c.command(update); command != "" && slices.Contains(c.CancelCommands, command) {
+			err := c.Storage.Delete(key)
+			if c.OnCancel != nil {
+				err = errors.Join(err, c.OnCancel(c.context(bot, update, key, record)))
+			}
+			return true, err
+		}
+
+		if !c.AllowReentry || c.entryFor(update) == nil {
+			step, ok := c.steps[record.State]
+			if !ok {
+				return true, errors.Join(fmt.Errorf("conversation %s is in undefined state %q", key, record.State), c.Storage.Delete(key))
+			}
+
+			ctx := c.context(bot, update, key, record)
+			if err := step.handler(ctx); err != nil {
+				return true, err
+			}
+			return true, c.finish(ctx, step)
+		}
+	}
+
+	entry := c.entryFor(update)
+	if entry == nil {
+		return timeoutErr != nil, timeoutErr
+	}
+
+	ctx := c.context(bot, update, key, ConversationRecord{})
+	if err := entry.handler(ctx); err != nil {
+		return true, errors.Join(timeoutErr, err)
+	}
+	if active && !ctx.transitioned && !ctx.ended {
+		// A reentry that doesn't start the conversation again leaves the active one as it was.
+		return true, timeoutErr
+	}
+	return true, errors.Join(timeoutErr, c.finish(ctx, nil))
+}
+
+// Sweep ends the conversations that have timed out and calls OnTimeout for each of them. Without it, a conversation
+// is only found to have timed out when its next update arrives; call Sweep periodically, e.g. from a time.Ticker,
+// for OnTimeout to be called soon after the timeout.
+func (c *Conversation) Sweep(bot *Bot) error {
+	keys, err := c.Storage.Keys()
+	if err != nil {
+		return fmt.Errorf("error listing conversations: %w", err)
+	}
+
+	var errs []error
+	for _, key := range keys {
+		errs = append(errs, c.sweep(bot, key))
+	}
+	return errors.Join(errs...)
+}
+
+// sweep ends the conversation under key if it has timed out.
+func (c *Conversation) sweep(bot *Bot, key ConversationKey) error {
+	unlock := c.lock(key)
+	defer unlock()
+
+	record, err := c.Storage.Get(key)
+	if errors.Is(err, ErrConversationNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !c.expired(record) {
+		return nil
+	}
+	return c.timeOut(bot, Update{}, key, record)
+}
+
+// expired reports whether the conversation with the record has timed out.
+func (c *Conversation) expired(record ConversationRecord) bool {
+	return !record.ExpiresAt.IsZero() && !c.now().Before(record.ExpiresAt)
+}
+
+// timeOut ends the timed-out conversation under key and calls OnTimeout.
+func (c *Conversation) timeOut(bot *Bot, update Update, key ConversationKey, record ConversationRecord) error {
+	err := c.Storage.Delete(key)
+	if c.OnTimeout != nil {
+		err = errors.Join(err, c.OnTimeout(c.context(bot, update, key, record)))
+	}
+	return err
+}
+
+// Current returns the record of the active conversation under key, or ErrConversationNotFound if there is none.
+func (c *Conversation) Current(key ConversationKey) (ConversationRecord, error) {
+	return c.Storage.Get(key)
+}
+
+// Reset ends the conversation under key without calling any handler.
+func (c *Conversation) Reset(key ConversationKey) error {
+	unlock := c.lock(key)
+	defer unlock()
+
+	return c.Storage.Delete(key)
+}
+
+// finish applies the transitions requested by the handler of step, or by an entry point if step is nil, and stores
+// the resulting state.
+func (c *Conversation) finish(ctx *ConversationContext, step *ConversationStep) error {
+	var enterErr error
+	for i := 0; ctx.transitioned && !ctx.ended; i++ {
+		if i == maxConversationTransitions {
+			return fmt.Errorf("%w: more than %d transitions while handling one update", ErrInvalidTransition, maxConversationTransitions)
+		}
+
+		next, ok := c.steps[ctx.next]
+		if !ok {
+			return fmt.Errorf("%w: state %q is not defined", ErrInvalidTransition, ctx.next)
+		}
+		if step != nil && len(step.transitions) > 0 && !slices.Contains(step.transitions, next.name) {
+			return fmt.Errorf("%w: from %q to %q", ErrInvalidTransition, step.name, next.name)
+		}
+
+		ctx.State, ctx.transitioned, step = next.name, false, next
+		if next.onEnter != nil {
+			if enterErr = next.onEnter(ctx); enterErr != nil {
+				ctx.transitioned = false
+			}
+		}
+	}
+
+	if ctx.ended {
+		return errors.Join(enterErr, c.Storage.Delete(ctx.Key))
+	}
+	if ctx.State == "" {
+		return enterErr
+	}
+
+	now := c.now()
+	record := ConversationRecord{State: ctx.State, Data: ctx.data, UpdatedAt: now}
+	timeout := c.Timeout
+	if current := c.steps[ctx.State]; current != nil && current.timeout > 0 {
+		timeout = current.timeout
+	}
+	if timeout > 0 {
+		record.ExpiresAt = now.Add(timeout)
+	}
+	return errors.Join(enterErr, c.Storage.Set(ctx.Key, record))
+}
+
+// entryFor returns the first entry point matching the update, or nil if there is none.
+func (c *Conversation) entryFor(update Update) *conversationEntry {
+	for i := range c.entries {
+		if c.entries[i].match(update) {
+			return &c.entries[i]
+		}
+	}
+	return nil
+}
+
+// context creates the context the handlers of a conversation are called with.
+func (c *Conversation) context(bot *Bot, update Update, key ConversationKey, record ConversationRecord) *ConversationContext {
+	return &ConversationContext{Bot: bot, Update: update, Key: key, State: record.State, data: record.Data}
+}
+
+// lock acquires the lock of the conversation under key and returns the function releasing it.
+func (c *Conversation) lock(key ConversationKey) func() {
+	c.mu.Lock()
+	if c.locks == nil {
+		c.locks = make(map[ConversationKey]*conversationLock)
+	}
+	lock, ok := c.locks[key]
+	if !ok {
+		lock = &conversationLock{}
+		c.locks[key] = lock
+	}
+	lock.refs++
+	c.mu.Unlock()
+
+	lock.mu.Lock()
+	return func() {
+		lock.mu.Unlock()
+
+		c.mu.Lock()
+		lock.refs--
+		if lock.refs == 0 {
+			delete(c.locks, key)
+		}
+		c.mu.Unlock()
+	}
+}
+
+// now returns the current time.
+func (c *Conversation) now() time.Time {
+	if c.Now != nil {
+		return c.Now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,123 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ConversationContext is passed to the handlers of a conversation. It gives access to the update and to the data stored
+// in the conversation, and lets the handler move the conversation to another state.
+type ConversationContext struct {
+	// Bot that received the update.
+	Bot *Bot
+
+	// Update being handled.
+	Update Update
+
+	// Key of the conversation.
+	Key ConversationKey
+
+	// Name of the current state, or an empty string in entry point handlers.
+	State string
+
+	data         map[string]json.RawMessage
+	next         string
+	transitioned bool
+	ended        bool
+}
+
+// Transition moves the conversation to state once the handler returns without an error. The state's OnEnter handler,
+// if any, is called then.
+func (c *ConversationContext) Transition(state string) {
+	c.next, c.transitioned = state, true
+}
+
+// End ends the conversation once the handler returns without an error, deleting its data.
+func (c *ConversationContext) End() {
+	c.ended = true
+}
+
+// Set stores value, encoded as JSON, under name in the conversation's data.
+func (c *ConversationContext) Set(name string, value interface{}) error {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("error encoding conversation value %q: %w", name, err)
+	}
+	if c.data == nil {
+		c.data = make(map[string]json.RawMessage)
+	}
+	c.data[name] = encoded
+	return nil
+}
+
+// Get decodes the value stored under name into value. It reports whether a value is stored under name.
+func (c *ConversationContext) Get(name string, value interface{}) (bool, error) {
+	encoded, ok := c.data[name]
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal(encoded, value); err != nil {
+		return true, fmt.Errorf("error decoding conversation value %q: %w", name, err)
+	}
+	return true, nil
+}
+
+// Delete removes the value stored under name from the conversation's data.
+func (c *ConversationContext) Delete(name string) {
+	delete(c.data, name)
+}
+
+// replyKeyboardKey is the data name under which Reply records that the reply keyboard it sent is still shown.
+const replyKeyboardKey = "conversation:reply_keyboard"
+
+// Reply sends a text message to the conversation's chat and forum topic. markup may be nil.
+//
+// A reply keyboard sent with Reply stays until a message removes it, so the next message Reply sends without a reply keyboard
+// removes it with ReplyKeyboardRemove, e.g. after a contact was shared or when OnCancel confirms the cancellation.
+// Since a message can't carry both, an inline keyboard is then added to that message by editing it.
+func (c *ConversationContext) Reply(text string, markup ReplyMarkup) (Message, error) {
+	request := SendMessageRequest{ChatID: c.Key.ChatID, Text: text, ReplyMarkup: markup}
+	if c.Key.ThreadID != 0 {
+		threadID := c.Key.ThreadID
+		request.MessageThreadID = &threadID
+	}
+
+	// The markup is only replaced if a reply keyboard is shown, so that nothing changes for conversations that don't use one.
+	_, shown := c.data[replyKeyboardKey]
+	var inline *InlineKeyboardMarkup
+	switch m := markup.(type) {
+	case nil:
+		if shown {
+			request.ReplyMarkup = ReplyKeyboardRemove{RemoveKeyboard: true}
+		}
+	case InlineKeyboardMarkup:
+		if shown {
+			request.ReplyMarkup, inline = ReplyKeyboardRemove{RemoveKeyboard: true}, &m
+		}
+	case *InlineKeyboardMarkup:
+		if shown {
+			request.ReplyMarkup, inline = ReplyKeyboardRemove{RemoveKeyboard: true}, m
+		}
+	}
+
+	message, err := c.Bot.SendMessage(request)
+	if err != nil {
+		return message, err
+	}
+
+	switch request.ReplyMarkup.(type) {
+	case ReplyKeyboardMarkup, *ReplyKeyboardMarkup:
+		if c.data == nil {
+			c.data = make(map[string]json.RawMessage)
+		}
+		c.data[replyKeyboardKey] = json.RawMessage("true")
+	case ReplyKeyboardRemove, *ReplyKeyboardRemove:
+		delete(c.data, replyKeyboardKey)
+	}
+
+	if inline != nil {
+		messageID := message.MessageID
+		_, err = c.Bot.EditMessageReplyMarkup(EditMessageReplyMarkupRequest{ChatID: c.Key.ChatID, MessageID: &messageID, ReplyMarkup: inline})
+	}
+	return message, err
+}
@@ -0,0 +1,137 @@
+package telegram
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrConversationNotFound is returned by a ConversationStorage when no conversation is stored under the requested key.
+var ErrConversationNotFound = errors.New("conversation not found")
+
+// ConversationKey identifies a conversation: a user talking to the bot in a chat and, in forum supergroups, a topic.
+type ConversationKey struct {
+	ChatID int64 `json:"chat_id"`
+	UserID int64 `json:"user_id"`
+
+	// Identifier of the forum topic, or 0 outside of forum topics.
+	ThreadID int `json:"thread_id,omitempty"`
+}
+
+// ConversationKeyFor returns the key of the conversation the update belongs to. It returns false if the update has no chat
+// or no sender, as with inline queries and anonymous channel posts.
+func ConversationKeyFor(update Update) (ConversationKey, bool) {
+	chat, sender := update.Chat(), update.Sender()
+	if chat == nil || sender == nil {
+		return ConversationKey{}, false
+	}
+
+	key := ConversationKey{ChatID: chat.ID, UserID: sender.ID}
+	// Replies in supergroups carry the thread of the replied message too; only forum topics separate conversations.
+	if message := update.message(); message != nil && message.IsTopicMessage != nil && *message.IsTopicMessage && message.MessageThreadID != nil {
+		key.ThreadID = *message.MessageThreadID
+	}
+	return key, true
+}
+
+// String returns the key in the form "chat:user:thread".
+func (k ConversationKey) String() string {
+	return fmt.Sprintf("%d:%d:%d", k.ChatID, k.UserID, k.ThreadID)
+}
+
+// ConversationRecord is the stored state of an active conversation.
+type ConversationRecord struct {
+	// Name of the conversation state the user is in.
+	State string `json:"state"`
+
+	// Values stored by the state handlers, JSON-encoded.
+	Data map[string]json.RawMessage `json:"data,omitempty"`
+
+	// Time of the last update handled in the conversation.
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Time after which the conversation times out, or the zero time if it never does.
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// ConversationStorage keeps the state of active conversations. Implementations must be safe for concurrent use.
+type ConversationStorage interface {
+	// Get returns the record stored under key, or ErrConversationNotFound if there is none.
+	Get(key ConversationKey) (ConversationRecord, error)
+
+	// Set stores record under key, replacing any previous record.
+	Set(key ConversationKey, record ConversationRecord) error
+
+	// Delete removes the record stored under key. Deleting a missing record is not an error.
+	Delete(key ConversationKey) error
+
+	// Keys returns the keys of all stored records, in no particular order.
+	Keys() ([]ConversationKey, error)
+}
+
+// MemoryConversationStorage is an in-process ConversationStorage. Conversations are lost when the process exits.
+type MemoryConversationStorage struct {
+	mu      sync.Mutex
+	records map[ConversationKey]ConversationRecord
+}
+
+// NewMemoryConversationStorage creates an empty in-memory storage.
+func NewMemoryConversationStorage() *MemoryConversationStorage {
+	return &MemoryConversationStorage{records: make(map[ConversationKey]ConversationRecord)}
+}
+
+// Get returns the record stored under key.
+func (s *MemoryConversationStorage) Get(key ConversationKey) (ConversationRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[key]
+	if !ok {
+		return ConversationRecord{}, ErrConversationNotFound
+	}
+	return copyConversationRecord(record), nil
+}
+
+// Set stores record under key.
+func (s *MemoryConversationStorage) Set(key ConversationKey, record ConversationRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records[key] = copyConversationRecord(record)
+	return nil
+}
+
+// Delete removes the record stored under key.
+func (s *MemoryConversationStorage) Delete(key ConversationKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, key)
+	return nil
+}
+
+// Keys returns the keys of all stored records.
+func (s *MemoryConversationStorage) Keys() ([]ConversationKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]ConversationKey, 0, len(s.records))
+	for key := range s.records {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// copyConversationRecord returns a copy of record that doesn't share its data map with the original.
+func copyConversationRecord(record ConversationRecord) ConversationRecord {
+	if record.Data != nil {
+		data := make(map[string]json.RawMessage, len(record.Data))
+		for name, value := range record.Data {
+			data[name] = append(json.RawMessage(nil), value...)
+		}
+		record.Data = data
+	}
+	return record
+}
@@ -0,0 +1,70 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// CreateNewStickerSetRequest represents a request to create a new sticker set owned by a user.
+//
+// See "createNewStickerSet" https://core.telegram.org/bots/api#createnewstickerset
+type CreateNewStickerSetRequest struct {
+	// (Required) User identifier of created sticker set owner.
+	UserID int64 `json:"user_id"`
+
+	// (Required) Short name of sticker set, to be used in t.me/addstickers/ URLs (e.g., animals). Can contain only English letters, digits
+	// and underscores. Must begin with a letter, can't contain consecutive underscores and must end in "_by_<bot_username>".
+	// <bot_username> is case insensitive. 1-64 characters.
+	Name string `json:"name"`
+
+	// (Required) Sticker set title, 1-64 characters.
+	Title string `json:"title"`
+
+	// (Required) A JSON-serialized list of 1-50 initial stickers to be added to the sticker set.
+	Stickers []InputSticker `json:"stickers"`
+
+	// (Optional) Type of stickers in the set, pass “regular”, “mask”, or “custom_emoji”. By default, a regular sticker set is created.
+	StickerType *string `json:"sticker_type,omitempty"`
+
+	// (Optional) Pass True if stickers in the sticker set must be repainted to the color of text when used in messages, the accent color
+	// if used as emoji status, white on chat photos, or another appropriate color based on context; for custom emoji sticker sets only.
+	NeedsRepainting *bool `json:"needs_repainting,omitempty"`
+}
+
+// CreateNewStickerSet creates a new sticker set owned by a user. The bot will be able to edit the sticker set thus created.
+//
+// See "createNewStickerSet" https://core.telegram.org/bots/api#createnewstickerset
+func (b *Bot) CreateNewStickerSet(request CreateNewStickerSetRequest) error {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "createNewStickerSet", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
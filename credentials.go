@@ -0,0 +1,12 @@
+package telegram
+
+// Credentials is the JSON-serialized object obtained by decrypting EncryptedCredentials.data.
+//
+// See "Credentials" https://core.telegram.org/passport#credentials
+type Credentials struct {
+	// (Required) Credentials for encrypted data.
+	SecureData SecureData `json:"secure_data"`
+
+	// (Required) Bot-specified nonce.
+	Nonce string `json:"nonce"`
+}
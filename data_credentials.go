@@ -0,0 +1,12 @@
+package telegram
+
+// DataCredentials can be used to decrypt and authenticate EncryptedPassportElement.data.
+//
+// See "DataCredentials" https://core.telegram.org/passport#datacredentials
+type DataCredentials struct {
+	// (Required) Checksum of encrypted data.
+	DataHash string `json:"data_hash"`
+
+	// (Required) Secret of encrypted data.
+	Secret string `json:"secret"`
+}
@@ -0,0 +1,52 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// DeleteStickerFromSetRequest represents a request to delete a sticker from a set created by the bot.
+//
+// See "deleteStickerFromSet" https://core.telegram.org/bots/api#deletestickerfromset
+type DeleteStickerFromSetRequest struct {
+	// (Required) File identifier of the sticker.
+	Sticker string `json:"sticker"`
+}
+
+// DeleteStickerFromSet deletes a sticker from a set created by the bot.
+//
+// See "deleteStickerFromSet" https://core.telegram.org/bots/api#deletestickerfromset
+func (b *Bot) DeleteStickerFromSet(request DeleteStickerFromSetRequest) error {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "deleteStickerFromSet", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
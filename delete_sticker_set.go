@@ -0,0 +1,52 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// DeleteStickerSetRequest represents a request to delete a sticker set that was created by the bot.
+//
+// See "deleteStickerSet" https://core.telegram.org/bots/api#deletestickerset
+type DeleteStickerSetRequest struct {
+	// (Required) Sticker set name.
+	Name string `json:"name"`
+}
+
+// DeleteStickerSet deletes a sticker set that was created by the bot.
+//
+// See "deleteStickerSet" https://core.telegram.org/bots/api#deletestickerset
+func (b *Bot) DeleteStickerSet(request DeleteStickerSetRequest) error {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "deleteStickerSet", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
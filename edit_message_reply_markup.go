@@ -0,0 +1,77 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// EditMessageReplyMarkupRequest represents a request to edit only the reply markup of a message.
+//
+// See "editMessageReplyMarkup" https://core.telegram.org/bots/api#editmessagereplymarkup
+type EditMessageReplyMarkupRequest struct {
+	// (Optional) Unique identifier of the business connection on behalf of which the message to be edited was sent.
+	BusinessConnectionID *string `json:"business_connection_id,omitempty"`
+
+	// (Optional) Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target
+	// channel (in the format @channelusername).
+	ChatID interface{} `json:"chat_id,omitempty"` // Using interface{} to allow both Integer and String types.
+
+	// (Optional) Required if inline_message_id is not specified. Identifier of the message to edit.
+	MessageID *int `json:"message_id,omitempty"`
+
+	// (Optional) Required if chat_id and message_id are not specified. Identifier of the inline message.
+	InlineMessageID *string `json:"inline_message_id,omitempty"`
+
+	// (Optional) A JSON-serialized object for an inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageReplyMarkup edits only the reply markup of a message. On success, if the edited message is not an inline message,
+// the edited Message is returned, otherwise nil is returned. Note that business messages that were not sent by the bot and do
+// not contain an inline keyboard can only be edited within 48 hours from the time they were sent.
+//
+// See "editMessageReplyMarkup" https://core.telegram.org/bots/api#editmessagereplymarkup
+func (b *Bot) EditMessageReplyMarkup(request EditMessageReplyMarkupRequest) (*Message, error) {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return nil, fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "editMessageReplyMarkup", requestPayload)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      json.RawMessage    `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return nil, fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	// Inline messages are edited in place and the result is just True.
+	if bytes.Equal(bytes.TrimSpace(response.Result), []byte("true")) {
+		return nil, nil
+	}
+
+	var message Message
+	if err := json.Unmarshal(response.Result, &message); err != nil {
+		return nil, fmt.Errorf("error decoding edited message: %w", err)
+	}
+
+	return &message, nil
+}
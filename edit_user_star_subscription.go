@@ -0,0 +1,59 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// EditUserStarSubscriptionRequest represents a request to cancel or re-enable extension of a subscription paid in Telegram Stars.
+//
+// See "editUserStarSubscription" https://core.telegram.org/bots/api#edituserstarsubscription
+type EditUserStarSubscriptionRequest struct {
+	// (Required) Identifier of the user whose subscription will be edited.
+	UserID int64 `json:"user_id"`
+
+	// (Required) Telegram payment identifier for the subscription.
+	TelegramPaymentChargeID string `json:"telegram_payment_charge_id"`
+
+	// (Required) Pass True to cancel extension of the user subscription; the subscription must be active up to the end of the current
+	// subscription period. Pass False to allow the user to re-enable a subscription that was previously canceled by the bot.
+	IsCanceled bool `json:"is_canceled"`
+}
+
+// EditUserStarSubscription allows the bot to cancel or re-enable extension of a subscription paid in Telegram Stars.
+//
+// See "editUserStarSubscription" https://core.telegram.org/bots/api#edituserstarsubscription
+func (b *Bot) EditUserStarSubscription(request EditUserStarSubscriptionRequest) error {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "editUserStarSubscription", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
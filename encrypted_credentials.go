@@ -0,0 +1,17 @@
+package telegram
+
+// EncryptedCredentials describes data required for decrypting and authenticating EncryptedPassportElement.
+// See the Telegram Passport Documentation for a complete description of the data decryption and authentication processes.
+//
+// See "EncryptedCredentials" https://core.telegram.org/bots/api#encryptedcredentials
+type EncryptedCredentials struct {
+	// (Required) Base64-encoded encrypted JSON-serialized data with unique user's payload, data hashes and secrets required
+	// for EncryptedPassportElement decryption and authentication.
+	Data string `json:"data"`
+
+	// (Required) Base64-encoded data hash for data authentication.
+	Hash string `json:"hash"`
+
+	// (Required) Base64-encoded secret, encrypted with the bot's public RSA key, required for data decryption.
+	Secret string `json:"secret"`
+}
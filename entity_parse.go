@@ -0,0 +1,559 @@
+package telegram
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// EntityParseError is returned when text with a parse_mode can't be parsed into entities. It corresponds to the
+// “can't parse entities” errors of the Bot API.
+type EntityParseError struct {
+	// Byte offset in the parsed text at which the error was found.
+	Offset int
+
+	// Description of the error.
+	Message string
+}
+
+func (e *EntityParseError) Error() string {
+	return fmt.Sprintf("can't parse entities: %s at byte offset %d", e.Message, e.Offset)
+}
+
+// entityTextBuilder accumulates parsed text and its entities, tracking the text length in UTF-16 code units.
+type entityTextBuilder struct {
+	text     strings.Builder
+	length   int
+	entities []MessageEntity
+}
+
+func (b *entityTextBuilder) writeString(s string) {
+	b.text.WriteString(s)
+	b.length += utf16Length(s)
+}
+
+func (b *entityTextBuilder) writeRune(r rune) {
+	b.text.WriteRune(r)
+	b.length += utf16RuneLength(r)
+}
+
+// add records entity from start to the current end of the text. Empty entities are omitted.
+func (b *entityTextBuilder) add(entity MessageEntity, start int) {
+	entity.Offset = start
+	entity.Length = b.length - start
+	if entity.Length > 0 {
+		b.entities = append(b.entities, entity)
+	}
+}
+
+func (b *entityTextBuilder) build() (string, []MessageEntity) {
+	sortEntities(b.entities)
+	return b.text.String(), b.entities
+}
+
+// linkEntity returns the entity for a link to url, which is a text mention for “tg://user?id=” links.
+func linkEntity(url string) MessageEntity {
+	if id, ok := strings.CutPrefix(url, "tg://user?id="); ok {
+		if userID, err := strconv.ParseInt(id, 10, 64); err == nil {
+			return MessageEntity{Type: "text_mention", User: &User{ID: userID}}
+		}
+	}
+	return MessageEntity{Type: "text_link", URL: &url}
+}
+
+// htmlTagEntityTypes maps the supported HTML tags to entity types.
+var htmlTagEntityTypes = map[string]string{
+	"b": "bold", "strong": "bold",
+	"i": "italic", "em": "italic",
+	"u": "underline", "ins": "underline",
+	"s": "strikethrough", "strike": "strikethrough", "del": "strikethrough",
+	"tg-spoiler": "spoiler", "span": "spoiler",
+	"code": "code", "pre": "pre",
+	"a": "text_link", "tg-emoji": "custom_emoji",
+	"blockquote": "blockquote",
+}
+
+// openHTMLTag is a start tag waiting for its end tag.
+type openHTMLTag struct {
+	name   string
+	offset int
+	start  int
+	entity MessageEntity
+
+	// merged is set for a code tag directly inside a pre tag, which only sets the language of the pre entity.
+	merged bool
+}
+
+// ParseHTML parses text formatted with Telegram HTML into plain text and entities, following the rules the Bot API applies
+// to messages sent with parse_mode “HTML”. The entities' offsets and lengths are in UTF-16 code units.
+//
+// See "HTML style" https://core.telegram.org/bots/api#html-style
+func ParseHTML(s string) (string, []MessageEntity, error) {
+	var b entityTextBuilder
+	var open []openHTMLTag
+
+	for i := 0; i < len(s); {
+		switch s[i] {
+		case '&':
+			r, n := parseHTMLCharacterReference(s[i:])
+			b.writeRune(r)
+			i += n
+
+		case '<':
+			end := strings.IndexByte(s[i:], '>')
+			if end < 0 {
+				return "", nil, &EntityParseError{i, "unclosed start tag"}
+			}
+			tag := s[i+1 : i+end]
+
+			if name, ok := strings.CutPrefix(tag, "/"); ok {
+				name = strings.ToLower(strings.TrimSpace(name))
+				if len(open) == 0 || open[len(open)-1].name != name {
+					return "", nil, &EntityParseError{i, fmt.Sprintf("unexpected end tag %q", name)}
+				}
+				top := open[len(open)-1]
+				open = open[:len(open)-1]
+				if !top.merged {
+					b.add(top.entity, top.start)
+				}
+				i += end + 1
+				continue
+			}
+
+			name, attributes, err := parseHTMLTag(tag)
+			if err != nil {
+				return "", nil, &EntityParseError{i, err.Error()}
+			}
+			entityType, ok := htmlTagEntityTypes[name]
+			if !ok {
+				return "", nil, &EntityParseError{i, fmt.Sprintf("unsupported start tag %q", name)}
+			}
+			if len(open) > 0 && (open[len(open)-1].entity.Type == "code" ||
+				open[len(open)-1].entity.Type == "pre" && name != "code") {
+				return "", nil, &EntityParseError{i, fmt.Sprintf("tag %q can't be nested in %q", name, open[len(open)-1].name)}
+			}
+
+			tagOpen := openHTMLTag{name: name, offset: i, start: b.length, entity: MessageEntity{Type: entityType}}
+			switch name {
+			case "span":
+				if attributes["class"] != "tg-spoiler" {
+					return "", nil, &EntityParseError{i, `tag "span" must have class "tg-spoiler"`}
+				}
+			case "a":
+				tagOpen.entity = linkEntity(attributes["href"])
+			case "tg-emoji":
+				id, ok := attributes["emoji-id"]
+				if !ok {
+					return "", nil, &EntityParseError{i, `tag "tg-emoji" must have attribute "emoji-id"`}
+				}
+				tagOpen.entity.CustomEmojiID = &id
+			case "blockquote":
+				if _, expandable := attributes["expandable"]; expandable {
+					tagOpen.entity.Type = "expandable_blockquote"
+				}
+			case "code":
+				parent := len(open) - 1
+				if parent >= 0 && open[parent].name == "pre" && open[parent].start == b.length {
+					tagOpen.merged = true
+					if language, ok := strings.CutPrefix(attributes["class"], "language-"); ok && language != "" {
+						open[parent].entity.Language = &language
+					}
+				}
+			}
+			open = append(open, tagOpen)
+			i += end + 1
+
+		case '>':
+			return "", nil, &EntityParseError{i, `unexpected ">"; use "&gt;"`}
+
+		default:
+			r, n := utf8.DecodeRuneInString(s[i:])
+			b.writeRune(r)
+			i += n
+		}
+	}
+
+	if len(open) > 0 {
+		top := open[len(open)-1]
+		return "", nil, &EntityParseError{top.offset, fmt.Sprintf("can't find end tag corresponding to start tag %q", top.name)}
+	}
+
+	text, entities := b.build()
+	return text, entities, nil
+}
+
+// parseHTMLTag splits the contents of a start tag into its lowercase name and attributes.
+func parseHTMLTag(tag string) (string, map[string]string, error) {
+	tag = strings.TrimSuffix(strings.TrimSpace(tag), "/")
+	nameEnd := strings.IndexAny(tag, " \t\r\n")
+	if nameEnd < 0 {
+		nameEnd = len(tag)
+	}
+	name := strings.ToLower(tag[:nameEnd])
+	if name == "" {
+		return "", nil, fmt.Errorf("empty tag name")
+	}
+
+	attributes := make(map[string]string)
+	rest := strings.TrimSpace(tag[nameEnd:])
+	for rest != "" {
+		keyEnd := strings.IndexAny(rest, "= \t\r\n")
+		if keyEnd < 0 {
+			attributes[strings.ToLower(rest)] = ""
+			break
+		}
+		key := strings.ToLower(rest[:keyEnd])
+		rest = strings.TrimLeft(rest[keyEnd:], " \t\r\n")
+		if !strings.HasPrefix(rest, "=") {
+			attributes[key] = ""
+			continue
+		}
+		rest = strings.TrimLeft(rest[1:], " \t\r\n")
+
+		var value string
+		if rest != "" && (rest[0] == '"' || rest[0] == '\'') {
+			closing := strings.IndexByte(rest[1:], rest[0])
+			if closing < 0 {
+				return "", nil, fmt.Errorf("unclosed value of attribute %q", key)
+			}
+			value, rest = rest[1:closing+1], rest[closing+2:]
+		} else {
+			valueEnd := strings.IndexAny(rest, " \t\r\n")
+			if valueEnd < 0 {
+				valueEnd = len(rest)
+			}
+			value, rest = rest[:valueEnd], rest[valueEnd:]
+		}
+		attributes[key] = unescapeHTML(value)
+		rest = strings.TrimLeft(rest, " \t\r\n")
+	}
+	return name, attributes, nil
+}
+
+// parseHTMLCharacterReference decodes the character reference at the start of s. Only numeric references and &lt;, &gt;,
+// &amp; and &quot; are supported; anything else is a literal ampersand. It returns the character and the number of bytes consumed.
+func parseHTMLCharacterReference(s string) (rune, int) {
+	end := strings.IndexByte(s, ';')
+	if end < 0 || end > 10 {
+		return '&', 1
+	}
+	switch name := s[1:end]; name {
+	case "lt":
+		return '<', end + 1
+	case "gt":
+		return '>', end + 1
+	case "amp":
+		return '&', end + 1
+	case "quot":
+		return '"', end + 1
+	default:
+		var code int64
+		var err error
+		if hex, ok := strings.CutPrefix(strings.ToLower(name), "#x"); ok {
+			code, err = strconv.ParseInt(hex, 16, 32)
+		} else if decimal, ok := strings.CutPrefix(name, "#"); ok {
+			code, err = strconv.ParseInt(decimal, 10, 32)
+		} else {
+			return '&', 1
+		}
+		if err != nil || !utf8.ValidRune(rune(code)) {
+			return '&', 1
+		}
+		return rune(code), end + 1
+	}
+}
+
+// unescapeHTML decodes the character references in an attribute value.
+func unescapeHTML(s string) string {
+	if !strings.Contains(s, "&") {
+		return s
+	}
+	var out strings.Builder
+	for i := 0; i < len(s); {
+		if s[i] == '&' {
+			r, n := parseHTMLCharacterReference(s[i:])
+			out.WriteRune(r)
+			i += n
+			continue
+		}
+		out.WriteByte(s[i])
+		i++
+	}
+	return out.String()
+}
+
+// markdownV2Reserved lists the characters that must be escaped with a backslash in MarkdownV2 text.
+const markdownV2Reserved = "_*[]()~`>#+-=|{}.!"
+
+// openMarkdownEntity is an entity whose end marker hasn't been found yet.
+type openMarkdownEntity struct {
+	entityType string
+	offset     int
+	start      int
+}
+
+// ParseMarkdownV2 parses text formatted with Telegram MarkdownV2 into plain text and entities, following the rules the
+// Bot API applies to messages sent with parse_mode “MarkdownV2”. The entities' offsets and lengths are in UTF-16 code units.
+//
+// See "MarkdownV2 style" https://core.telegram.org/bots/api#markdownv2-style
+func ParseMarkdownV2(s string) (string, []MessageEntity, error) {
+	var b entityTextBuilder
+	var open []openMarkdownEntity
+	var quote *openMarkdownEntity
+
+	// closeQuote ends the open block quotation, before the newline that was just written if beforeNewline is set.
+	closeQuote := func(beforeNewline bool) {
+		length := b.length
+		if beforeNewline {
+			length--
+		}
+		if length > quote.start {
+			b.entities = append(b.entities, MessageEntity{Type: quote.entityType, Offset: quote.start, Length: length - quote.start})
+		}
+		quote = nil
+	}
+
+	find := func(entityType string) int {
+		for i := len(open) - 1; i >= 0; i-- {
+			if open[i].entityType == entityType {
+				return i
+			}
+		}
+		return -1
+	}
+
+	toggle := func(entityType string, offset int) {
+		if i := find(entityType); i >= 0 {
+			b.add(MessageEntity{Type: entityType}, open[i].start)
+			open = append(open[:i], open[i+1:]...)
+			return
+		}
+		open = append(open, openMarkdownEntity{entityType: entityType, offset: offset, start: b.length})
+	}
+
+	lineStart := true
+	for i := 0; i < len(s); {
+		if lineStart {
+			lineStart = false
+			switch {
+			case strings.HasPrefix(s[i:], "**>"):
+				if quote != nil {
+					closeQuote(true)
+				}
+				quote = &openMarkdownEntity{entityType: "expandable_blockquote", offset: i, start: b.length}
+				i += 3
+				continue
+			case s[i] == '>':
+				if quote == nil {
+					quote = &openMarkdownEntity{entityType: "blockquote", offset: i, start: b.length}
+				}
+				i++
+				continue
+			case quote != nil:
+				closeQuote(true)
+			}
+		}
+
+		c := s[i]
+		switch {
+		case c == '\\':
+			if i+1 >= len(s) {
+				return "", nil, &EntityParseError{i, `unfinished escape sequence; use "\\\\" for a backslash`}
+			}
+			r, n := utf8.DecodeRuneInString(s[i+1:])
+			b.writeRune(r)
+			i += 1 + n
+
+		case c == '\r':
+			i++
+
+		case c == '\n':
+			b.writeRune('\n')
+			lineStart = true
+			i++
+
+		case strings.HasPrefix(s[i:], "```"):
+			end, err := parseMarkdownV2Pre(&b, s, i)
+			if err != nil {
+				return "", nil, err
+			}
+			i = end
+
+		case c == '`':
+			end, err := parseMarkdownV2Code(&b, s, i)
+			if err != nil {
+				return "", nil, err
+			}
+			i = end
+
+		case c == '*':
+			toggle("bold", i)
+			i++
+
+		case strings.HasPrefix(s[i:], "__"):
+			toggle("underline", i)
+			i += 2
+
+		case c == '_':
+			toggle("italic", i)
+			i++
+
+		case c == '~':
+			toggle("strikethrough", i)
+			i++
+
+		case strings.HasPrefix(s[i:], "||"):
+			atLineEnd := i+2 == len(s) || s[i+2] == '\n'
+			if quote != nil && quote.entityType == "expandable_blockquote" && atLineEnd && find("spoiler") < 0 {
+				closeQuote(false)
+				i += 2
+				continue
+			}
+			toggle("spoiler", i)
+			i += 2
+
+		case c == '[' || strings.HasPrefix(s[i:], "!["):
+			entityType := "text_link"
+			if c == '!' {
+				entityType = "custom_emoji"
+				i++
+			}
+			open = append(open, openMarkdownEntity{entityType: entityType, offset: i, start: b.length})
+			i++
+
+		case c == ']':
+			at := find("text_link")
+			if emoji := find("custom_emoji"); emoji > at {
+				at = emoji
+			}
+			if at < 0 {
+				return "", nil, &EntityParseError{i, `character "]" is reserved and must be escaped with the preceding "\"`}
+			}
+			if i+1 >= len(s) || s[i+1] != '(' {
+				return "", nil, &EntityParseError{i, `link text must be followed by the URL in parentheses`}
+			}
+			url, end, err := parseMarkdownV2URL(s, i+2)
+			if err != nil {
+				return "", nil, err
+			}
+
+			link := open[at]
+			open = append(open[:at], open[at+1:]...)
+			if link.entityType == "custom_emoji" {
+				id, ok := strings.CutPrefix(url, "tg://emoji?id=")
+				if !ok {
+					return "", nil, &EntityParseError{i + 2, "custom emoji URL must have the form tg://emoji?id=<id>"}
+				}
+				b.add(MessageEntity{Type: "custom_emoji", CustomEmojiID: &id}, link.start)
+			} else {
+				b.add(linkEntity(url), link.start)
+			}
+			i = end
+
+		case strings.IndexByte(markdownV2Reserved, c) >= 0:
+			return "", nil, &EntityParseError{i, fmt.Sprintf(`character %q is reserved and must be escaped with the preceding "\"`, c)}
+
+		default:
+			r, n := utf8.DecodeRuneInString(s[i:])
+			b.writeRune(r)
+			i += n
+		}
+	}
+
+	if len(open) > 0 {
+		return "", nil, &EntityParseError{open[0].offset, fmt.Sprintf("can't find end of %s entity", open[0].entityType)}
+	}
+	if quote != nil {
+		if quote.entityType == "expandable_blockquote" {
+			return "", nil, &EntityParseError{quote.offset, `can't find "||" at the end of expandable blockquote`}
+		}
+		// A trailing newline ends the quotation's last line rather than belonging to it.
+		closeQuote(lineStart)
+	}
+
+	text, entities := b.build()
+	return text, entities, nil
+}
+
+// parseMarkdownV2Code parses an inline code entity starting with the backtick at s[start] and returns the offset after it.
+func parseMarkdownV2Code(b *entityTextBuilder, s string, start int) (int, error) {
+	begin := b.length
+	for i := start + 1; i < len(s); {
+		switch s[i] {
+		case '\\':
+			if i+1 >= len(s) {
+				return 0, &EntityParseError{i, "unfinished escape sequence"}
+			}
+			r, n := utf8.DecodeRuneInString(s[i+1:])
+			b.writeRune(r)
+			i += 1 + n
+		case '`':
+			b.add(MessageEntity{Type: "code"}, begin)
+			return i + 1, nil
+		default:
+			r, n := utf8.DecodeRuneInString(s[i:])
+			b.writeRune(r)
+			i += n
+		}
+	}
+	return 0, &EntityParseError{start, "can't find end of code entity"}
+}
+
+// parseMarkdownV2Pre parses a pre entity starting with the backticks at s[start] and returns the offset after it.
+// A language may follow the opening backticks on their line; a newline before the closing backticks isn't part of the entity.
+func parseMarkdownV2Pre(b *entityTextBuilder, s string, start int) (int, error) {
+	i := start + 3
+	var language *string
+	if newline := strings.IndexByte(s[i:], '\n'); newline >= 0 && !strings.Contains(s[i:i+newline], "`") {
+		if newline > 0 {
+			lang := strings.TrimSpace(s[i : i+newline])
+			language = &lang
+		}
+		i += newline + 1
+	}
+
+	var content strings.Builder
+	for i < len(s) {
+		switch {
+		case s[i] == '\\':
+			if i+1 >= len(s) {
+				return 0, &EntityParseError{i, "unfinished escape sequence"}
+			}
+			r, n := utf8.DecodeRuneInString(s[i+1:])
+			content.WriteRune(r)
+			i += 1 + n
+		case strings.HasPrefix(s[i:], "```"):
+			begin := b.length
+			b.writeString(strings.TrimSuffix(content.String(), "\n"))
+			b.add(MessageEntity{Type: "pre", Language: language}, begin)
+			return i + 3, nil
+		default:
+			r, n := utf8.DecodeRuneInString(s[i:])
+			content.WriteRune(r)
+			i += n
+		}
+	}
+	return 0, &EntityParseError{start, "can't find end of pre entity"}
+}
+
+// parseMarkdownV2URL parses a link URL starting at s[start], just after the opening parenthesis, and returns it together
+// with the offset after the closing parenthesis.
+func parseMarkdownV2URL(s string, start int) (string, int, error) {
+	var url strings.Builder
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 >= len(s) {
+				return "", 0, &EntityParseError{i, "unfinished escape sequence"}
+			}
+			i++
+			url.WriteByte(s[i])
+		case ')':
+			return url.String(), i + 1, nil
+		default:
+			url.WriteByte(s[i])
+		}
+	}
+	return "", 0, &EntityParseError{start - 1, "can't find end of URL"}
+}
@@ -0,0 +1,642 @@
+package telegram
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// entityRenderer writes the markup of a text format. content is the text covered by the entity.
+type entityRenderer interface {
+	open(out *bytes.Buffer, entity MessageEntity, content string)
+	close(out *bytes.Buffer, entity MessageEntity, content string)
+	text(out *bytes.Buffer, text string, open []MessageEntity)
+}
+
+// RenderHTML renders text with its entities as Telegram HTML, suitable for sending with parse_mode “HTML”.
+// Entities that Telegram detects automatically, such as mentions, hashtags and URLs, are left as plain text.
+//
+// See "HTML style" https://core.telegram.org/bots/api#html-style
+func RenderHTML(text string, entities []MessageEntity) string {
+	return renderEntities(text, entities, htmlRenderer{})
+}
+
+// RenderMarkdownV2 renders text with its entities as Telegram MarkdownV2, suitable for sending with parse_mode “MarkdownV2”.
+// Entities that Telegram detects automatically, such as mentions, hashtags and URLs, are left as plain text.
+//
+// See "MarkdownV2 style" https://core.telegram.org/bots/api#markdownv2-style
+func RenderMarkdownV2(text string, entities []MessageEntity) string {
+	return renderEntities(text, entities, &markdownV2Renderer{})
+}
+
+// RenderCommonMark renders text with its entities as CommonMark, e.g. for archiving messages outside of Telegram.
+// Underline is rendered as inline HTML and strikethrough with the widely supported “~~” extension; spoilers and custom emoji,
+// which have no Markdown equivalent, are rendered as plain text.
+//
+// Bold, italic and strikethrough entities only take effect in CommonMark where their markers are flanked by the text as the
+// specification requires, e.g. not inside a word next to punctuation. Such entities are rendered as inline HTML instead.
+func RenderCommonMark(text string, entities []MessageEntity) string {
+	r := &commonMarkRenderer{}
+	return r.insertEmphasis(renderEntities(text, commonMarkEntities(text, entities), r))
+}
+
+// HTML renders the message text, or its caption if it has no text, as Telegram HTML.
+func (m Message) HTML() string {
+	text, entities := m.formattedText()
+	return RenderHTML(text, entities)
+}
+
+// MarkdownV2 renders the message text, or its caption if it has no text, as Telegram MarkdownV2.
+func (m Message) MarkdownV2() string {
+	text, entities := m.formattedText()
+	return RenderMarkdownV2(text, entities)
+}
+
+// CommonMark renders the message text, or its caption if it has no text, as CommonMark.
+func (m Message) CommonMark() string {
+	text, entities := m.formattedText()
+	return RenderCommonMark(text, entities)
+}
+
+// formattedText returns the message text and entities, or the caption and caption entities if the message has no text.
+func (m Message) formattedText() (string, []MessageEntity) {
+	switch {
+	case m.Text != nil:
+		return *m.Text, m.Entities
+	case m.Caption != nil:
+		return *m.Caption, m.CaptionEntities
+	default:
+		return "", nil
+	}
+}
+
+// renderedEntityTypes lists the entity types that have markup; other entities are detected by Telegram from the text itself.
+var renderedEntityTypes = map[string]bool{
+	"bold": true, "italic": true, "underline": true, "strikethrough": true, "spoiler": true, "code": true, "pre": true,
+	"text_link": true, "text_mention": true, "custom_emoji": true, "blockquote": true, "expandable_blockquote": true,
+}
+
+// renderEntities walks the text in UTF-16 code units and lets r write the markup of the entities and the text between them.
+//
+// Entities that overlap without nesting are split: when an entity ends while entities opened after it are still open,
+// those are closed and reopened around its end, so that the markup is always properly nested.
+func renderEntities(text string, entities []MessageEntity, r entityRenderer) string {
+	units := utf16.Encode([]rune(text))
+	decode := func(from, to int) string {
+		return string(utf16.Decode(units[from:to]))
+	}
+
+	var sorted []MessageEntity
+	for _, entity := range entities {
+		if !renderedEntityTypes[entity.Type] || entity.Length <= 0 || entity.Offset < 0 || entity.Offset >= len(units) {
+			continue
+		}
+		entity.Length = min(entity.Length, len(units)-entity.Offset)
+		sorted = append(sorted, entity)
+	}
+	sortEntities(sorted)
+
+	points := []int{len(units)}
+	for _, entity := range sorted {
+		points = append(points, entity.Offset, entity.Offset+entity.Length)
+	}
+	sort.Ints(points)
+
+	end := func(entity MessageEntity) int {
+		return entity.Offset + entity.Length
+	}
+	content := func(entity MessageEntity) string {
+		return decode(entity.Offset, end(entity))
+	}
+
+	out := new(bytes.Buffer)
+	var open []MessageEntity
+	position, next := 0, 0
+	for _, point := range points {
+		if point > position {
+			r.text(out, decode(position, point), open)
+			position = point
+		}
+
+		first := len(open)
+		for i, entity := range open {
+			if end(entity) == point {
+				first = i
+				break
+			}
+		}
+
+		var reopen []MessageEntity
+		for i := len(open) - 1; i >= first; i-- {
+			r.close(out, open[i], content(open[i]))
+			if end(open[i]) != point {
+				reopen = append(reopen, open[i])
+			}
+		}
+		open = open[:first]
+		for i := len(reopen) - 1; i >= 0; i-- {
+			r.open(out, reopen[i], content(reopen[i]))
+			open = append(open, reopen[i])
+		}
+
+		for ; next < len(sorted) && sorted[next].Offset == point; next++ {
+			r.open(out, sorted[next], content(sorted[next]))
+			open = append(open, sorted[next])
+		}
+	}
+
+	return out.String()
+}
+
+// hasOpenEntity reports whether one of the open entities has the given type.
+func hasOpenEntity(open []MessageEntity, types ...string) bool {
+	for _, entity := range open {
+		for _, t := range types {
+			if entity.Type == t {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// htmlRenderer renders Telegram HTML.
+type htmlRenderer struct{}
+
+var htmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+func (htmlRenderer) open(out *bytes.Buffer, entity MessageEntity, content string) {
+	switch entity.Type {
+	case "bold":
+		out.WriteString("<b>")
+	case "italic":
+		out.WriteString("<i>")
+	case "underline":
+		out.WriteString("<u>")
+	case "strikethrough":
+		out.WriteString("<s>")
+	case "spoiler":
+		out.WriteString("<tg-spoiler>")
+	case "code":
+		out.WriteString("<code>")
+	case "pre":
+		out.WriteString("<pre>")
+		if entity.Language != nil {
+			out.WriteString(`<code class="language-` + htmlEscaper.Replace(*entity.Language) + `">`)
+		}
+	case "text_link":
+		out.WriteString(`<a href="` + htmlEscaper.Replace(stringValue(entity.URL)) + `">`)
+	case "text_mention":
+		out.WriteString(`<a href="tg://user?id=` + strconv.FormatInt(entityUserID(entity), 10) + `">`)
+	case "custom_emoji":
+		out.WriteString(`<tg-emoji emoji-id="` + htmlEscaper.Replace(stringValue(entity.CustomEmojiID)) + `">`)
+	case "blockquote":
+		out.WriteString("<blockquote>")
+	case "expandable_blockquote":
+		out.WriteString("<blockquote expandable>")
+	}
+}
+
+func (htmlRenderer) close(out *bytes.Buffer, entity MessageEntity, content string) {
+	switch entity.Type {
+	case "bold":
+		out.WriteString("</b>")
+	case "italic":
+		out.WriteString("</i>")
+	case "underline":
+		out.WriteString("</u>")
+	case "strikethrough":
+		out.WriteString("</s>")
+	case "spoiler":
+		out.WriteString("</tg-spoiler>")
+	case "code":
+		out.WriteString("</code>")
+	case "pre":
+		if entity.Language != nil {
+			out.WriteString("</code>")
+		}
+		out.WriteString("</pre>")
+	case "text_link", "text_mention":
+		out.WriteString("</a>")
+	case "custom_emoji":
+		out.WriteString("</tg-emoji>")
+	case "blockquote", "expandable_blockquote":
+		out.WriteString("</blockquote>")
+	}
+}
+
+func (htmlRenderer) text(out *bytes.Buffer, text string, open []MessageEntity) {
+	out.WriteString(htmlEscaper.Replace(text))
+}
+
+// markdownV2Renderer renders Telegram MarkdownV2.
+type markdownV2Renderer struct {
+	// lineStart is set after a newline inside a block quotation, whose next line must start with “>”.
+	lineStart bool
+}
+
+var (
+	markdownV2Escaper     = strings.NewReplacer(markdownV2EscapePairs(`\_*[]()~` + "`" + `>#+-=|{}.!`)...)
+	markdownV2CodeEscaper = strings.NewReplacer(markdownV2EscapePairs(`\` + "`")...)
+	markdownV2LinkEscaper = strings.NewReplacer(markdownV2EscapePairs(`\)`)...)
+)
+
+// markdownV2EscapePairs returns replacer pairs that prefix each of the characters with a backslash.
+func markdownV2EscapePairs(characters string) []string {
+	var pairs []string
+	for _, c := range characters {
+		pairs = append(pairs, string(c), `\`+string(c))
+	}
+	return pairs
+}
+
+func (r *markdownV2Renderer) open(out *bytes.Buffer, entity MessageEntity, content string) {
+	switch entity.Type {
+	case "bold":
+		r.marker(out, "*")
+	case "italic":
+		r.marker(out, "_")
+	case "underline":
+		r.marker(out, "__")
+	case "strikethrough":
+		r.marker(out, "~")
+	case "spoiler":
+		r.marker(out, "||")
+	case "code":
+		r.marker(out, "`")
+	case "pre":
+		r.marker(out, "```"+stringValue(entity.Language)+"\n")
+	case "text_link", "text_mention", "custom_emoji":
+		if entity.Type == "custom_emoji" {
+			r.marker(out, "!")
+		}
+		r.marker(out, "[")
+	case "blockquote":
+		r.marker(out, ">")
+	case "expandable_blockquote":
+		r.marker(out, "**>")
+	}
+}
+
+func (r *markdownV2Renderer) close(out *bytes.Buffer, entity MessageEntity, content string) {
+	switch entity.Type {
+	case "bold":
+		r.marker(out, "*")
+	case "italic":
+		r.marker(out, "_")
+	case "underline":
+		r.marker(out, "__")
+	case "strikethrough":
+		r.marker(out, "~")
+	case "spoiler":
+		r.marker(out, "||")
+	case "code":
+		r.marker(out, "`")
+	case "pre":
+		// The newline before the closing backticks isn't part of the entity.
+		out.WriteString("\n")
+		r.marker(out, "```")
+	case "text_link":
+		r.marker(out, "]("+markdownV2LinkEscaper.Replace(stringValue(entity.URL))+")")
+	case "text_mention":
+		r.marker(out, "](tg://user?id="+strconv.FormatInt(entityUserID(entity), 10)+")")
+	case "custom_emoji":
+		r.marker(out, "](tg://emoji?id="+markdownV2LinkEscaper.Replace(stringValue(entity.CustomEmojiID))+")")
+	case "blockquote":
+		r.lineStart = false
+	case "expandable_blockquote":
+		r.lineStart = false
+		// The expandability mark must end the last line of the quotation.
+		if trimmed := bytes.TrimRight(out.Bytes(), "\n"); len(trimmed) < out.Len() {
+			newlines := out.Len() - len(trimmed)
+			out.Truncate(len(trimmed))
+			out.WriteString("||" + strings.Repeat("\n", newlines))
+			return
+		}
+		out.WriteString("||")
+	}
+}
+
+func (r *markdownV2Renderer) text(out *bytes.Buffer, text string, open []MessageEntity) {
+	escaper := markdownV2Escaper
+	if hasOpenEntity(open, "code", "pre") {
+		escaper = markdownV2CodeEscaper
+	}
+	quoted := hasOpenEntity(open, "blockquote", "expandable_blockquote")
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if i > 0 {
+			out.WriteString("\n")
+			r.lineStart = quoted
+		}
+		if line == "" && (i == len(lines)-1 || !r.lineStart) {
+			continue
+		}
+		if r.lineStart {
+			out.WriteString(">")
+			r.lineStart = false
+		}
+		out.WriteString(escaper.Replace(line))
+	}
+}
+
+// marker writes entity markup. Adjacent markers made of the same character, such as the end of an italic entity followed by
+// the end of an underline entity, would be ambiguous, so they are separated with a carriage return, which Telegram ignores.
+func (r *markdownV2Renderer) marker(out *bytes.Buffer, marker string) {
+	if r.lineStart {
+		out.WriteString(">")
+		r.lineStart = false
+	}
+
+	written := out.Bytes()
+	if len(written) > 0 && written[len(written)-1] == marker[0] && strings.IndexByte("_*~|`", marker[0]) >= 0 &&
+		(len(written) < 2 || written[len(written)-2] != '\\') {
+		out.WriteString("\r")
+	}
+	out.WriteString(marker)
+}
+
+// commonMarkRenderer renders CommonMark.
+type commonMarkRenderer struct {
+	// lineStart is set after a newline inside a block quotation, whose next line must start with “> ”.
+	lineStart bool
+
+	// emphasis lists the markers of bold, italic and strikethrough entities in the order of their positions. They are inserted
+	// by insertEmphasis once the text on both sides of them is known.
+	emphasis []emphasisMarker
+
+	// openEmphasis holds the indexes of the opening markers in emphasis that haven't been closed yet.
+	openEmphasis []int
+}
+
+// emphasisMarker is the position of an emphasis marker in the rendered text.
+type emphasisMarker struct {
+	entityType string
+	position   int
+
+	// closing is the index of the matching closing marker, for opening markers.
+	closing int
+}
+
+// commonMarkEmphasis maps emphasis entity types to their CommonMark delimiters and the HTML elements used where the
+// delimiters can't take effect.
+var commonMarkEmphasis = map[string]struct{ delimiter, element string }{
+	"bold": {"**", "strong"},
+	// Underscores don't delimit emphasis inside words, asterisks do.
+	"italic":        {"*", "em"},
+	"strikethrough": {"~~", "del"},
+}
+
+var (
+	commonMarkEscaper     = strings.NewReplacer(markdownV2EscapePairs(`\` + "`" + `*_{}[]()#+-.!<>|~`)...)
+	commonMarkLinkEscaper = strings.NewReplacer(`\`, `\\`, "(", `\(`, ")", `\)`, " ", "%20", "<", "%3C", ">", "%3E")
+)
+
+// commonMarkEntities prepares emphasis entities for CommonMark, whose delimiters only work when flanked by non-whitespace:
+// adjacent and overlapping entities of the same type are merged, so that their markers don't run together, and leading
+// and trailing whitespace is moved out of them.
+func commonMarkEntities(text string, entities []MessageEntity) []MessageEntity {
+	units := utf16.Encode([]rune(text))
+	space := func(i int) bool {
+		return i >= 0 && i < len(units) && !utf16.IsSurrogate(rune(units[i])) && unicode.IsSpace(rune(units[i]))
+	}
+
+	var prepared []MessageEntity
+	merged := make(map[string]int)
+	sorted := append([]MessageEntity(nil), entities...)
+	sortEntities(sorted)
+	for _, entity := range sorted {
+		if _, ok := commonMarkEmphasis[entity.Type]; !ok {
+			prepared = append(prepared, entity)
+			continue
+		}
+		if i, ok := merged[entity.Type]; ok && entity.Offset <= prepared[i].Offset+prepared[i].Length {
+			prepared[i].Length = max(prepared[i].Length, entity.Offset+entity.Length-prepared[i].Offset)
+			continue
+		}
+		merged[entity.Type] = len(prepared)
+		prepared = append(prepared, entity)
+	}
+
+	trimmed := prepared[:0]
+	for _, entity := range prepared {
+		if _, ok := commonMarkEmphasis[entity.Type]; ok {
+			for entity.Length > 0 && space(entity.Offset) {
+				entity.Offset++
+				entity.Length--
+			}
+			for entity.Length > 0 && space(entity.Offset+entity.Length-1) {
+				entity.Length--
+			}
+			if entity.Length == 0 {
+				continue
+			}
+		}
+		trimmed = append(trimmed, entity)
+	}
+	return trimmed
+}
+
+func (r *commonMarkRenderer) open(out *bytes.Buffer, entity MessageEntity, content string) {
+	switch entity.Type {
+	case "bold", "italic", "strikethrough":
+		r.marker(out, "")
+		r.openEmphasis = append(r.openEmphasis, len(r.emphasis))
+		r.emphasis = append(r.emphasis, emphasisMarker{entityType: entity.Type, position: out.Len()})
+	case "underline":
+		r.marker(out, "<u>")
+	case "code":
+		fence := backtickFence(content, 1)
+		r.marker(out, fence)
+		if strings.HasPrefix(content, "`") || strings.HasSuffix(content, "`") {
+			out.WriteString(" ")
+		}
+	case "pre":
+		if out.Len() > 0 && !bytes.HasSuffix(out.Bytes(), []byte("\n")) {
+			out.WriteString("\n")
+		}
+		r.marker(out, backtickFence(content, 3)+stringValue(entity.Language)+"\n")
+	case "text_link", "text_mention":
+		r.marker(out, "[")
+	case "blockquote", "expandable_blockquote":
+		if out.Len() > 0 && !bytes.HasSuffix(out.Bytes(), []byte("\n")) {
+			out.WriteString("\n")
+		}
+		r.marker(out, "> ")
+	}
+}
+
+func (r *commonMarkRenderer) close(out *bytes.Buffer, entity MessageEntity, content string) {
+	switch entity.Type {
+	case "bold", "italic", "strikethrough":
+		// Entities are closed in the reverse order of opening, so the emphasis closed is the one opened last.
+		r.marker(out, "")
+		opening := r.openEmphasis[len(r.openEmphasis)-1]
+		r.openEmphasis = r.openEmphasis[:len(r.openEmphasis)-1]
+		r.emphasis[opening].closing = len(r.emphasis)
+		r.emphasis = append(r.emphasis, emphasisMarker{entityType: entity.Type, position: out.Len()})
+	case "underline":
+		r.marker(out, "</u>")
+	case "code":
+		if strings.HasPrefix(content, "`") || strings.HasSuffix(content, "`") {
+			out.WriteString(" ")
+		}
+		r.marker(out, backtickFence(content, 1))
+	case "pre":
+		if !strings.HasSuffix(content, "\n") {
+			out.WriteString("\n")
+		}
+		r.marker(out, backtickFence(content, 3)+"\n")
+	case "text_link":
+		r.marker(out, "]("+commonMarkLinkEscaper.Replace(stringValue(entity.URL))+")")
+	case "text_mention":
+		r.marker(out, "](tg://user?id="+strconv.FormatInt(entityUserID(entity), 10)+")")
+	case "blockquote", "expandable_blockquote":
+		// A blank line ends the quotation; otherwise the following text would continue it.
+		r.lineStart = false
+		if !bytes.HasSuffix(out.Bytes(), []byte("\n")) {
+			out.WriteString("\n")
+		}
+		out.WriteString("\n")
+	}
+}
+
+func (r *commonMarkRenderer) text(out *bytes.Buffer, text string, open []MessageEntity) {
+	verbatim := hasOpenEntity(open, "code", "pre")
+	quoted := hasOpenEntity(open, "blockquote", "expandable_blockquote")
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if i > 0 {
+			out.WriteString("\n")
+			r.lineStart = quoted
+		}
+		if line == "" && (i == len(lines)-1 || !r.lineStart) {
+			continue
+		}
+		if r.lineStart {
+			out.WriteString("> ")
+			r.lineStart = false
+		}
+		if verbatim {
+			out.WriteString(line)
+		} else {
+			out.WriteString(commonMarkEscaper.Replace(line))
+		}
+	}
+}
+
+// marker writes entity markup, continuing a block quotation first if needed.
+func (r *commonMarkRenderer) marker(out *bytes.Buffer, marker string) {
+	if r.lineStart {
+		out.WriteString("> ")
+		r.lineStart = false
+	}
+	out.WriteString(marker)
+}
+
+// insertEmphasis inserts the emphasis markers into the rendered text. An entity gets CommonMark delimiters if both of them
+// are flanked as the specification requires, and HTML tags otherwise.
+func (r *commonMarkRenderer) insertEmphasis(rendered string) string {
+	markup := make([]string, len(r.emphasis))
+	for i, marker := range r.emphasis {
+		if markup[i] != "" {
+			continue
+		}
+		emphasis := commonMarkEmphasis[marker.entityType]
+		if r.flanked(rendered, i, true) && r.flanked(rendered, marker.closing, false) {
+			markup[i], markup[marker.closing] = emphasis.delimiter, emphasis.delimiter
+		} else {
+			markup[i], markup[marker.closing] = "<"+emphasis.element+">", "</"+emphasis.element+">"
+		}
+	}
+
+	var out strings.Builder
+	last := 0
+	for i, marker := range r.emphasis {
+		out.WriteString(rendered[last:marker.position])
+		out.WriteString(markup[i])
+		last = marker.position
+	}
+	out.WriteString(rendered[last:])
+	return out.String()
+}
+
+// flanked reports whether the emphasis marker at index i can open or close emphasis: an opening delimiter must be
+// left-flanking and a closing one right-flanking. Markers next to each other count as punctuation, which both delimiters
+// and HTML tags are.
+//
+// See "Emphasis and strong emphasis" https://spec.commonmark.org/0.31.2/#emphasis-and-strong-emphasis
+func (r *commonMarkRenderer) flanked(rendered string, i int, opening bool) bool {
+	position := r.emphasis[i].position
+
+	before, after := commonMarkPunctuation, commonMarkPunctuation
+	if i == 0 || r.emphasis[i-1].position != position {
+		before = commonMarkClass(rendered[:position], true)
+	}
+	if i == len(r.emphasis)-1 || r.emphasis[i+1].position != position {
+		after = commonMarkClass(rendered[position:], false)
+	}
+
+	if opening {
+		return after != commonMarkWhitespace && (after != commonMarkPunctuation || before != commonMarkOther)
+	}
+	return before != commonMarkWhitespace && (before != commonMarkPunctuation || after != commonMarkOther)
+}
+
+// Character classes that decide whether emphasis delimiters are flanking.
+const (
+	commonMarkWhitespace = iota
+	commonMarkPunctuation
+	commonMarkOther
+)
+
+// commonMarkClass classifies the last character of s if last is set and its first character otherwise. The beginning and end
+// of the text count as whitespace.
+func commonMarkClass(s string, last bool) int {
+	var c rune
+	switch {
+	case s == "":
+		return commonMarkWhitespace
+	case last:
+		c, _ = utf8.DecodeLastRuneInString(s)
+	default:
+		c, _ = utf8.DecodeRuneInString(s)
+	}
+
+	switch {
+	case unicode.IsSpace(c):
+		return commonMarkWhitespace
+	case unicode.IsPunct(c) || unicode.IsSymbol(c):
+		return commonMarkPunctuation
+	default:
+		return commonMarkOther
+	}
+}
+
+// backtickFence returns a run of backticks longer than any run in content and at least minimum long.
+func backtickFence(content string, minimum int) string {
+	longest, run := 0, 0
+	for _, c := range content {
+		if c == '`' {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+	return strings.Repeat("`", max(minimum, longest+1))
+}
+
+// entityUserID returns the identifier of the user mentioned by a “text_mention” entity.
+func entityUserID(entity MessageEntity) int64 {
+	if entity.User == nil {
+		return 0
+	}
+	return entity.User.ID
+}
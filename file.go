@@ -0,0 +1,21 @@
+package telegram
+
+// File represents a file ready to be downloaded. The file can be downloaded via the link https://api.telegram.org/file/bot<token>/<file_path>.
+// It is guaranteed that the link will be valid for at least 1 hour. When the link expires, a new one can be requested by calling getFile.
+//
+// The maximum file size to download is 20 MB.
+//
+// See "File" https://core.telegram.org/bots/api#file
+type File struct {
+	// (Required) Identifier for this file, which can be used to download or reuse the file.
+	FileID string `json:"file_id"`
+
+	// (Required) Unique identifier for this file, which is supposed to be the same over time and for different bots. Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+
+	// (Optional) File size in bytes.
+	FileSize *int64 `json:"file_size,omitempty"`
+
+	// (Optional) File path. Use https://api.telegram.org/file/bot<token>/<file_path> to get the file.
+	FilePath *string `json:"file_path,omitempty"`
+}
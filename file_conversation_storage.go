@@ -0,0 +1,129 @@
+package telegram
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// FileConversationStorage is a ConversationStorage that keeps each conversation in a JSON file in a directory,
+// so conversations survive restarts. Files are replaced atomically, so a crash never leaves a partially written record.
+//
+// The directory must not be shared by several processes.
+type FileConversationStorage struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewFileConversationStorage creates a storage keeping its files in dir, creating the directory if it doesn't exist.
+func NewFileConversationStorage(dir string) (*FileConversationStorage, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("error creating conversation directory: %w", err)
+	}
+	return &FileConversationStorage{dir: dir}, nil
+}
+
+// Get returns the record stored under key.
+func (s *FileConversationStorage) Get(key ConversationKey) (ConversationRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := os.ReadFile(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return ConversationRecord{}, ErrConversationNotFound
+	}
+	if err != nil {
+		return ConversationRecord{}, fmt.Errorf("error reading conversation %s: %w", key, err)
+	}
+
+	var record ConversationRecord
+	if err := json.Unmarshal(content, &record); err != nil {
+		return ConversationRecord{}, fmt.Errorf("error decoding conversation %s: %w", key, err)
+	}
+	return record, nil
+}
+
+// Set stores record under key.
+func (s *FileConversationStorage) Set(key ConversationKey, record ConversationRecord) error {
+	content, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("error encoding conversation %s: %w", key, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := writeFileAtomic(s.path(key), content); err != nil {
+		return fmt.Errorf("error writing conversation %s: %w", key, err)
+	}
+	return nil
+}
+
+// Delete removes the record stored under key.
+func (s *FileConversationStorage) Delete(key ConversationKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error deleting conversation %s: %w", key, err)
+	}
+	return nil
+}
+
+// Keys returns the keys of all stored records.
+func (s *FileConversationStorage) Keys() ([]ConversationKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading conversation directory: %w", err)
+	}
+
+	var keys []ConversationKey
+	for _, entry := range entries {
+		// Temporary files left behind by an interrupted write end in .tmp and are skipped.
+		name, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok {
+			continue
+		}
+		var key ConversationKey
+		if _, err := fmt.Sscanf(name, "%d_%d_%d", &key.ChatID, &key.UserID, &key.ThreadID); err != nil {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// path returns the name of the file the record under key is kept in.
+func (s *FileConversationStorage) path(key ConversationKey) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%d_%d_%d.json", key.ChatID, key.UserID, key.ThreadID))
+}
+
+// writeFileAtomic writes content to a temporary file next to name and renames it to name.
+func writeFileAtomic(name string, content []byte) error {
+	file, err := os.CreateTemp(filepath.Dir(name), filepath.Base(name)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(content); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), name)
+}
@@ -0,0 +1,12 @@
+package telegram
+
+// FileCredentials can be used to decrypt and authenticate EncryptedPassportElement files.
+//
+// See "FileCredentials" https://core.telegram.org/passport#filecredentials
+type FileCredentials struct {
+	// (Required) Checksum of encrypted file.
+	FileHash string `json:"file_hash"`
+
+	// (Required) Secret of encrypted file.
+	Secret string `json:"secret"`
+}
@@ -0,0 +1,211 @@
+package telegram
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fileSessionVersionBlock is the number of versions a FileSessionStore reserves at a time, so that its counter file is only
+// written once per block rather than on every write. Versions reserved but not used before the process exits are skipped.
+const fileSessionVersionBlock = 1024
+
+// FileSessionStore is a SessionStore that keeps each session in a JSON file in a directory, so sessions survive restarts.
+// Files are replaced atomically, so a crash never leaves a partially written session.
+//
+// The version counter is kept in a file named "version" in the same directory. Expired sessions are removed when they
+// are read, or by Sweep. The directory must not be shared by several processes.
+type FileSessionStore struct {
+	// (Optional) Returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	dir      string
+	mu       sync.Mutex
+	version  uint64
+	reserved uint64
+}
+
+// NewFileSessionStore creates a store keeping its files in dir, creating the directory if it doesn't exist.
+func NewFileSessionStore(dir string) (*FileSessionStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("error creating session directory: %w", err)
+	}
+
+	s := &FileSessionStore{dir: dir}
+	content, err := os.ReadFile(s.versionPath())
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("error reading session version: %w", err)
+	}
+	if err == nil {
+		if s.reserved, err = strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64); err != nil {
+			return nil, fmt.Errorf("error decoding session version: %w", err)
+		}
+	}
+	// Versions up to the reserved one may have been used before the store was last closed.
+	s.version = s.reserved
+	return s, nil
+}
+
+// Get returns the session stored under key.
+func (s *FileSessionStore) Get(key string) (SessionRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok, err := s.read(key)
+	if err != nil {
+		return SessionRecord{}, err
+	}
+	if !ok {
+		return SessionRecord{}, ErrSessionNotFound
+	}
+	if sessionExpired(record.ExpiresAt, s.now()) {
+		if err := s.remove(key); err != nil {
+			return SessionRecord{}, err
+		}
+		return SessionRecord{}, ErrSessionNotFound
+	}
+	return record, nil
+}
+
+// Set stores data under key if the stored session has the given version.
+func (s *FileSessionStore) Set(key string, data []byte, version uint64, ttl time.Duration) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	stored, ok, err := s.read(key)
+	if err != nil {
+		return 0, err
+	}
+	if version != currentSessionVersion(stored, ok, now) {
+		return 0, ErrSessionConflict
+	}
+
+	next, err := s.nextVersion()
+	if err != nil {
+		return 0, err
+	}
+	record := SessionRecord{Data: data, Version: next, ExpiresAt: sessionExpiry(now, ttl)}
+	if err := s.write(key, record); err != nil {
+		return 0, err
+	}
+	return record.Version, nil
+}
+
+// Delete removes the session stored under key.
+func (s *FileSessionStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.remove(key)
+}
+
+// Sweep removes the sessions that have expired. Files that can't be read are left in place and reported.
+func (s *FileSessionStore) Sweep() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return fmt.Errorf("error reading session directory: %w", err)
+	}
+
+	now := s.now()
+	var errs []error
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok {
+			continue
+		}
+		key, err := base64.RawURLEncoding.DecodeString(name)
+		if err != nil {
+			continue
+		}
+
+		record, ok, err := s.read(string(key))
+		if err == nil && ok && sessionExpired(record.ExpiresAt, now) {
+			err = s.remove(string(key))
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// nextVersion returns the next version from the counter, reserving a new block of versions first if needed.
+func (s *FileSessionStore) nextVersion() (uint64, error) {
+	if s.version == s.reserved {
+		reserved := s.reserved + fileSessionVersionBlock
+		if err := writeFileAtomic(s.versionPath(), []byte(strconv.FormatUint(reserved, 10))); err != nil {
+			return 0, fmt.Errorf("error writing session version: %w", err)
+		}
+		s.reserved = reserved
+	}
+	s.version++
+	return s.version, nil
+}
+
+// read returns the session stored under key, and whether there is one.
+func (s *FileSessionStore) read(key string) (SessionRecord, bool, error) {
+	content, err := os.ReadFile(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return SessionRecord{}, false, nil
+	}
+	if err != nil {
+		return SessionRecord{}, false, fmt.Errorf("error reading session %q: %w", key, err)
+	}
+
+	var record SessionRecord
+	if err := json.Unmarshal(content, &record); err != nil {
+		return SessionRecord{}, false, fmt.Errorf("error decoding session %q: %w", key, err)
+	}
+	return record, true, nil
+}
+
+// write replaces the session stored under key.
+func (s *FileSessionStore) write(key string, record SessionRecord) error {
+	content, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("error encoding session %q: %w", key, err)
+	}
+	if err := writeFileAtomic(s.path(key), content); err != nil {
+		return fmt.Errorf("error writing session %q: %w", key, err)
+	}
+	return nil
+}
+
+// remove deletes the file of the session stored under key, if any.
+func (s *FileSessionStore) remove(key string) error {
+	if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error deleting session %q: %w", key, err)
+	}
+	return nil
+}
+
+// path returns the name of the file the session under key is kept in. Keys are encoded, so they may contain any characters.
+func (s *FileSessionStore) path(key string) string {
+	return filepath.Join(s.dir, base64.RawURLEncoding.EncodeToString([]byte(key))+".json")
+}
+
+// versionPath returns the name of the file the version counter is kept in. It can't clash with a session file, whose
+// names end in ".json".
+func (s *FileSessionStore) versionPath() string {
+	return filepath.Join(s.dir, "version")
+}
+
+// now returns the current time.
+func (s *FileSessionStore) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,334 @@
+package telegram
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Form is a wizard that asks the user for a sequence of typed fields within a Conversation, one message per field,
+// and asks for confirmation before submitting the answers.
+//
+// Each field's prompt offers a button to go back to the previous field and, for optional fields, one to skip it;
+// the "/back" and "/skip" commands work as well. Invalid answers are rejected with the field's error message and the
+// field is asked again. After the last field a summary of the answers is shown, and OnSubmit is called once the user
+// confirms it. The answers can then be decoded into a struct with Decode.
+//
+// Contact and location fields are asked with a reply keyboard, which ConversationContext.Reply removes with the next prompt,
+// or with the reply of the conversation's OnCancel if the form is canceled while the keyboard is shown.
+type Form struct {
+	// (Required) Name of the form, unique within its conversation. It prefixes the form's conversation states, data and callback
+	// data, so it should be short: callback data is limited to 64 bytes.
+	Name string
+
+	// (Required) Called when the user confirms the answers. The conversation ends afterwards unless OnSubmit transitions
+	// to another state.
+	OnSubmit ConversationHandlerFunc
+
+	// (Optional) Text shown above the summary of the answers. NewForm sets a default.
+	ConfirmPrompt string
+
+	// (Optional) Texts of the navigation buttons. NewForm sets defaults.
+	BackText, SkipText, ConfirmText string
+
+	// (Optional) Message sent when the user tries to skip a required field. NewForm sets a default.
+	RequiredMessage string
+
+	// (Optional) Message sent when the user answers the summary with anything but confirming or going back. NewForm sets a default.
+	ConfirmMessage string
+
+	fields []*FormField
+}
+
+// formNavigation is a navigation action requested by the user.
+type formNavigation int
+
+const (
+	formAnswer formNavigation = iota
+	formBack
+	formSkip
+	formConfirm
+)
+
+// NewForm creates a form without fields that calls onSubmit once the user confirms the answers.
+func NewForm(name string, onSubmit ConversationHandlerFunc) *Form {
+	return &Form{
+		Name:            name,
+		OnSubmit:        onSubmit,
+		ConfirmPrompt:   "Please check your answers:",
+		BackText:        "« Back",
+		SkipText:        "Skip »",
+		ConfirmText:     "Confirm",
+		RequiredMessage: "This field can't be skipped.",
+		ConfirmMessage:  "Please confirm your answers or go back.",
+	}
+}
+
+// add appends field to the form.
+func (f *Form) add(field *FormField) *FormField {
+	f.fields = append(f.fields, field)
+	return field
+}
+
+// Register defines the form's states in conversation. Fields must be added before. It returns an error, without defining
+// any state, if the callback data of a button would exceed the 64 bytes Telegram allows, e.g. because Name is too long.
+func (f *Form) Register(conversation *Conversation) error {
+	for i := 0; i <= len(f.fields); i++ {
+		// Navigation suffixes are at most four bytes long; choices are identified by their index.
+		longest := "back"
+		if i < len(f.fields) && len(f.fields[i].choices) > 0 {
+			if index := strconv.Itoa(len(f.fields[i].choices) - 1); len(index) > len(longest) {
+				longest = index
+			}
+		}
+		if data := f.state(i) + ":" + longest; len(data) > maxCallbackDataLength {
+			return fmt.Errorf("form %q: callback data %q exceeds %d bytes", f.Name, data, maxCallbackDataLength)
+		}
+	}
+
+	for i := range f.fields {
+		step := conversation.State(f.state(i), f.handleField(i)).OnEnter(f.prompt(i)).To(f.state(i), f.state(i+1))
+		if i > 0 {
+			step.To(f.state(i - 1))
+		}
+	}
+	conversation.State(f.state(len(f.fields)), f.handleConfirm).OnEnter(f.summary)
+	return nil
+}
+
+// Start clears any previous answers and asks for the first field. Call it from an entry point or a state handler.
+func (f *Form) Start(c *ConversationContext) {
+	for _, field := range f.fields {
+		c.Delete(f.valueKey(field))
+		c.Delete(f.displayKey(field))
+	}
+	c.Transition(f.state(0))
+}
+
+// Decode decodes the answers into v, typically a pointer to a struct, as if they were the members of a JSON object
+// named after the fields. Struct fields are matched like encoding/json does, so json tags can be used to map them.
+func (f *Form) Decode(c *ConversationContext, v interface{}) error {
+	values := make(map[string]json.RawMessage)
+	for _, field := range f.fields {
+		if value, ok := c.data[f.valueKey(field)]; ok {
+			values[field.name] = value
+		}
+	}
+
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Errorf("error encoding form %q: %w", f.Name, err)
+	}
+	if err := json.Unmarshal(encoded, v); err != nil {
+		return fmt.Errorf("error decoding form %q: %w", f.Name, err)
+	}
+	return nil
+}
+
+// handleField returns the handler of the state asking for the field at index.
+func (f *Form) handleField(index int) ConversationHandlerFunc {
+	return func(c *ConversationContext) error {
+		field := f.fields[index]
+		data, current, answerErr := f.callbackData(c, index)
+		if !current {
+			return answerErr
+		}
+
+		switch f.navigation(c.Update, data) {
+		case formBack:
+			c.Transition(f.state(max(index-1, 0)))
+			return answerErr
+		case formSkip:
+			if !field.optional {
+				return errors.Join(answerErr, f.reject(c, index, f.RequiredMessage))
+			}
+			c.Delete(f.valueKey(field))
+			c.Delete(f.displayKey(field))
+			c.Transition(f.state(index + 1))
+			return answerErr
+		}
+
+		value, display, ok := field.parse(c.Update, data)
+		if !ok {
+			return errors.Join(answerErr, f.reject(c, index, field.errorMessage))
+		}
+		if err := c.Set(f.valueKey(field), value); err != nil {
+			return errors.Join(answerErr, err)
+		}
+		if err := c.Set(f.displayKey(field), display); err != nil {
+			return errors.Join(answerErr, err)
+		}
+		c.Transition(f.state(index + 1))
+		return answerErr
+	}
+}
+
+// reject answers an update the field at index doesn't accept with text. Contact and location fields send their keyboard
+// again, since a reply without one would remove it.
+func (f *Form) reject(c *ConversationContext, index int, text string) error {
+	var markup ReplyMarkup
+	if f.fields[index].requestButton != nil {
+		markup = f.markup(index)
+	}
+	_, err := c.Reply(text, markup)
+	return err
+}
+
+// handleConfirm is the handler of the state showing the summary.
+func (f *Form) handleConfirm(c *ConversationContext) error {
+	data, current, answerErr := f.callbackData(c, len(f.fields))
+	if !current {
+		return answerErr
+	}
+
+	switch f.navigation(c.Update, data) {
+	case formConfirm:
+		if err := f.OnSubmit(c); err != nil {
+			return errors.Join(answerErr, err)
+		}
+		if !c.transitioned {
+			c.End()
+		}
+		return answerErr
+	case formBack:
+		if len(f.fields) > 0 {
+			c.Transition(f.state(len(f.fields) - 1))
+		}
+		return answerErr
+	default:
+		_, err := c.Reply(f.ConfirmMessage, nil)
+		return errors.Join(answerErr, err)
+	}
+}
+
+// prompt returns the OnEnter handler asking for the field at index.
+func (f *Form) prompt(index int) ConversationHandlerFunc {
+	return func(c *ConversationContext) error {
+		_, err := c.Reply(f.fields[index].prompt, f.markup(index))
+		return err
+	}
+}
+
+// summary is the OnEnter handler of the confirmation state.
+func (f *Form) summary(c *ConversationContext) error {
+	var text strings.Builder
+	text.WriteString(f.ConfirmPrompt)
+	text.WriteString("\n")
+	for _, field := range f.fields {
+		label := field.label
+		if label == "" {
+			label = field.name
+		}
+		display := "—"
+		if _, err := c.Get(f.displayKey(field), &display); err != nil {
+			return err
+		}
+		fmt.Fprintf(&text, "\n%s: %s", label, display)
+	}
+
+	prefix := f.state(len(f.fields)) + ":"
+	buttons := []InlineKeyboardButton{InlineButtonData(f.ConfirmText, prefix+"ok")}
+	if len(f.fields) > 0 {
+		buttons = append([]InlineKeyboardButton{InlineButtonData(f.BackText, prefix+"back")}, buttons...)
+	}
+	_, err := c.Reply(text.String(), InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{buttons}})
+	return err
+}
+
+// markup returns the keyboard sent with the prompt of the field at index: a reply keyboard with the request button for
+// contact and location fields, and an inline keyboard with the choices and navigation buttons otherwise.
+func (f *Form) markup(index int) ReplyMarkup {
+	field := f.fields[index]
+
+	if field.requestButton != nil {
+		var navigation []KeyboardButton
+		if index > 0 {
+			navigation = append(navigation, ReplyButton(f.BackText))
+		}
+		if field.optional {
+			navigation = append(navigation, ReplyButton(f.SkipText))
+		}
+		keyboard := [][]KeyboardButton{{*field.requestButton}}
+		if len(navigation) > 0 {
+			keyboard = append(keyboard, navigation)
+		}
+		resize, oneTime := true, true
+		return ReplyKeyboardMarkup{Keyboard: keyboard, ResizeKeyboard: &resize, OneTimeKeyboard: &oneTime}
+	}
+
+	prefix := f.state(index) + ":"
+	var keyboard [][]InlineKeyboardButton
+	for i, choice := range field.choices {
+		keyboard = append(keyboard, []InlineKeyboardButton{InlineButtonData(choice.Label, prefix+strconv.Itoa(i))})
+	}
+	var navigation []InlineKeyboardButton
+	if index > 0 {
+		navigation = append(navigation, InlineButtonData(f.BackText, prefix+"back"))
+	}
+	if field.optional {
+		navigation = append(navigation, InlineButtonData(f.SkipText, prefix+"skip"))
+	}
+	if len(navigation) > 0 {
+		keyboard = append(keyboard, navigation)
+	}
+	if len(keyboard) == 0 {
+		return nil
+	}
+	return InlineKeyboardMarkup{InlineKeyboard: keyboard}
+}
+
+// callbackData answers the update's callback query, if any, and returns its data after the prefix of the state at index.
+// It reports false for callback queries from buttons of other states, e.g. those of prompts answered before.
+func (f *Form) callbackData(c *ConversationContext, index int) (string, bool, error) {
+	query := c.Update.CallbackQuery
+	if query == nil {
+		return "", true, nil
+	}
+
+	err := c.Bot.AnswerCallbackQuery(AnswerCallbackQueryRequest{CallbackQueryID: query.ID})
+	if query.Data == nil {
+		return "", false, err
+	}
+	data, ok := strings.CutPrefix(*query.Data, f.state(index)+":")
+	return data, ok, err
+}
+
+// navigation returns the navigation action requested by the update, given its callback data.
+func (f *Form) navigation(update Update, data string) formNavigation {
+	switch data {
+	case "back":
+		return formBack
+	case "skip":
+		return formSkip
+	case "ok":
+		return formConfirm
+	}
+
+	if message := update.Message; message != nil {
+		switch {
+		case message.Command() == "back", message.Text != nil && *message.Text == f.BackText:
+			return formBack
+		case message.Command() == "skip", message.Text != nil && *message.Text == f.SkipText:
+			return formSkip
+		}
+	}
+	return formAnswer
+}
+
+// state returns the name of the conversation state asking for the field at index, or showing the summary if index
+// is the number of fields.
+func (f *Form) state(index int) string {
+	return fmt.Sprintf("form:%s:%d", f.Name, index)
+}
+
+// valueKey returns the conversation data name the answer to field is stored under.
+func (f *Form) valueKey(field *FormField) string {
+	return "form:" + f.Name + ":" + field.name
+}
+
+// displayKey returns the conversation data name the summary text of the answer to field is stored under.
+func (f *Form) displayKey(field *FormField) string {
+	return f.valueKey(field) + ":text"
+}
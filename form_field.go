@@ -0,0 +1,171 @@
+package telegram
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormChoice is an option of a choice field of a Form.
+type FormChoice struct {
+	// Text of the option's button.
+	Label string
+
+	// Value stored when the option is chosen.
+	Value string
+}
+
+// FormField is a field of a Form, as returned by the Form's field methods.
+type FormField struct {
+	name          string
+	prompt        string
+	label         string
+	errorMessage  string
+	optional      bool
+	pattern       *regexp.Regexp
+	choices       []FormChoice
+	requestButton *KeyboardButton
+
+	// parse extracts the field's value and the text shown for it in the summary from an update. data is the part
+	// of the callback data after the field's prefix for callback queries, and empty otherwise.
+	parse func(update Update, data string) (value interface{}, display string, ok bool)
+}
+
+// Text adds a field accepting any text message. The text is stored as a string.
+func (f *Form) Text(name, prompt string) *FormField {
+	field := &FormField{name: name, prompt: prompt, errorMessage: "Please send a text message."}
+	field.parse = func(update Update, _ string) (interface{}, string, bool) {
+		if update.Message == nil || update.Message.Text == nil {
+			return nil, "", false
+		}
+		text := strings.TrimSpace(*update.Message.Text)
+		if text == "" || field.pattern != nil && !field.pattern.MatchString(text) {
+			return nil, "", false
+		}
+		return text, text, true
+	}
+	return f.add(field)
+}
+
+// Int adds a field accepting a whole number from min to max, stored as an int.
+func (f *Form) Int(name, prompt string, min, max int) *FormField {
+	field := &FormField{name: name, prompt: prompt, errorMessage: fmt.Sprintf("Please send a whole number from %d to %d.", min, max)}
+	field.parse = func(update Update, _ string) (interface{}, string, bool) {
+		if update.Message == nil || update.Message.Text == nil {
+			return nil, "", false
+		}
+		number, err := strconv.Atoi(strings.TrimSpace(*update.Message.Text))
+		if err != nil || number < min || number > max {
+			return nil, "", false
+		}
+		return number, strconv.Itoa(number), true
+	}
+	return f.add(field)
+}
+
+// Choice adds a field offering choices as inline keyboard buttons, one per row. The Value of the chosen option
+// is stored as a string.
+func (f *Form) Choice(name, prompt string, choices ...FormChoice) *FormField {
+	field := &FormField{name: name, prompt: prompt, choices: choices, errorMessage: "Please choose one of the options."}
+	field.parse = func(_ Update, data string) (interface{}, string, bool) {
+		index, err := strconv.Atoi(data)
+		if err != nil || index < 0 || index >= len(choices) {
+			return nil, "", false
+		}
+		return choices[index].Value, choices[index].Label, true
+	}
+	return f.add(field)
+}
+
+// Contact adds a field asking the user to share their phone number with a reply keyboard button labeled buttonText.
+// Only the user's own contact is accepted. The Contact is stored.
+func (f *Form) Contact(name, prompt, buttonText string) *FormField {
+	button := ReplyButtonContact(buttonText)
+	field := &FormField{name: name, prompt: prompt, requestButton: &button, errorMessage: "Please share your contact with the button below."}
+	field.parse = func(update Update, _ string) (interface{}, string, bool) {
+		if update.Message == nil || update.Message.Contact == nil {
+			return nil, "", false
+		}
+		contact := *update.Message.Contact
+		if sender := update.Sender(); sender == nil || contact.UserID == nil || *contact.UserID != sender.ID {
+			return nil, "", false
+		}
+		return contact, contact.PhoneNumber, true
+	}
+	return f.add(field)
+}
+
+// Location adds a field asking the user to share a location, offering a reply keyboard button labeled buttonText
+// that sends the current one. The Location is stored.
+func (f *Form) Location(name, prompt, buttonText string) *FormField {
+	button := ReplyButtonLocation(buttonText)
+	field := &FormField{name: name, prompt: prompt, requestButton: &button, errorMessage: "Please share a location."}
+	field.parse = func(update Update, _ string) (interface{}, string, bool) {
+		if update.Message == nil || update.Message.Location == nil {
+			return nil, "", false
+		}
+		location := *update.Message.Location
+		return location, fmt.Sprintf("%.5f, %.5f", location.Latitude, location.Longitude), true
+	}
+	return f.add(field)
+}
+
+// Photo adds a field accepting a photo. The file identifier of its largest size is stored as a string.
+func (f *Form) Photo(name, prompt string) *FormField {
+	field := &FormField{name: name, prompt: prompt, errorMessage: "Please send a photo."}
+	field.parse = func(update Update, _ string) (interface{}, string, bool) {
+		if update.Message == nil || len(update.Message.Photo) == 0 {
+			return nil, "", false
+		}
+		return update.Message.Photo[len(update.Message.Photo)-1].FileID, "photo", true
+	}
+	return f.add(field)
+}
+
+// Date adds a field accepting a date written in the given time.Parse layout, e.g. "2006-01-02". The date is stored
+// as a time.Time in UTC.
+func (f *Form) Date(name, prompt, layout string) *FormField {
+	field := &FormField{name: name, prompt: prompt, errorMessage: fmt.Sprintf("Please send a date like %s.", layout)}
+	field.parse = func(update Update, _ string) (interface{}, string, bool) {
+		if update.Message == nil || update.Message.Text == nil {
+			return nil, "", false
+		}
+		date, err := time.Parse(layout, strings.TrimSpace(*update.Message.Text))
+		if err != nil {
+			return nil, "", false
+		}
+		return date, date.Format(layout), true
+	}
+	return f.add(field)
+}
+
+// Pattern makes a text field accept only text matching pattern. It has no effect on other fields.
+func (f *FormField) Pattern(pattern *regexp.Regexp) *FormField {
+	f.pattern = pattern
+	return f
+}
+
+// Optional lets the user skip the field. Skipped fields leave the corresponding struct field unchanged when decoding.
+func (f *FormField) Optional() *FormField {
+	f.optional = true
+	return f
+}
+
+// Label sets the name the field is shown with in the confirmation summary. Defaults to the field's name.
+func (f *FormField) Label(label string) *FormField {
+	f.label = label
+	return f
+}
+
+// Error sets the message sent when the user's answer is invalid.
+func (f *FormField) Error(message string) *FormField {
+	f.errorMessage = message
+	return f
+}
+
+// Name returns the name of the field.
+func (f *FormField) Name() string {
+	return f.name
+}
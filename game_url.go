@@ -0,0 +1,174 @@
+package telegram
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrGameURLInvalid is returned when the parameters of a game URL are missing, tampered with or expired.
+var ErrGameURLInvalid = errors.New("game URL is invalid or expired")
+
+// GameSession identifies the player and the game message a game was launched from. It carries everything needed
+// to report a score back with setGameScore.
+type GameSession struct {
+	// Identifier of the player.
+	UserID int64
+
+	// Short name of the launched game.
+	GameShortName string
+
+	// Chat and message identifiers of the game message, for games sent by the bot. Zero for inline messages.
+	ChatID    int64
+	MessageID int
+
+	// Identifier of the inline message, for games sent in inline mode. Empty otherwise.
+	InlineMessageID string
+
+	// Time the URL was issued.
+	IssuedAt time.Time
+}
+
+// SetGameScoreRequest returns a request that sets score for the session's player in the session's game message.
+func (s GameSession) SetGameScoreRequest(score int) SetGameScoreRequest {
+	request := SetGameScoreRequest{UserID: s.UserID, Score: score}
+	if s.InlineMessageID != "" {
+		inlineMessageID := s.InlineMessageID
+		request.InlineMessageID = &inlineMessageID
+	} else {
+		chatID, messageID := s.ChatID, s.MessageID
+		request.ChatID = &chatID
+		request.MessageID = &messageID
+	}
+	return request
+}
+
+// GameURLSigner builds game URLs that carry a signed GameSession as query parameters, so an HTML5 game server can trust
+// the player and message identity without talking to Telegram.
+//
+// The signature is the hex-encoded HMAC-SHA256, keyed with Secret, of the other parameters sorted by name and joined
+// as "name=value" lines, the same scheme Telegram uses for login data.
+type GameURLSigner struct {
+	// (Required) URL of the game. Session parameters are added to its query string.
+	BaseURL string
+
+	// (Required) Key used to sign the parameters. Keep it secret and share it only with the game server.
+	Secret []byte
+
+	// (Optional) How long a URL remains valid. Zero means URLs never expire.
+	MaxAge time.Duration
+}
+
+// URL returns a signed game URL for the callback query sent when the user pressed a game button.
+func (s GameURLSigner) URL(query CallbackQuery) (string, error) {
+	if query.GameShortName == nil {
+		return "", errors.New("callback query is not a game callback query")
+	}
+
+	base, err := url.Parse(s.BaseURL)
+	if err != nil {
+		return "", fmt.Errorf("error parsing game base URL: %w", err)
+	}
+
+	params := url.Values{}
+	params.Set("user_id", strconv.FormatInt(query.From.ID, 10))
+	params.Set("game", *query.GameShortName)
+	params.Set("issued_at", strconv.FormatInt(time.Now().Unix(), 10))
+	if query.InlineMessageID != nil {
+		params.Set("inline_message_id", *query.InlineMessageID)
+	} else if query.Message != nil {
+		params.Set("chat_id", strconv.FormatInt(query.Message.Chat().ID, 10))
+		params.Set("message_id", strconv.Itoa(query.Message.MessageID()))
+	} else {
+		return "", errors.New("game callback query has neither a message nor an inline message")
+	}
+	params.Set("hash", s.sign(params))
+
+	values := base.Query()
+	for key, value := range params {
+		values[key] = value
+	}
+	base.RawQuery = values.Encode()
+
+	return base.String(), nil
+}
+
+// Verify checks the signature and age of the parameters of a game URL created by URL and returns the session they describe.
+func (s GameURLSigner) Verify(values url.Values) (GameSession, error) {
+	hash := values.Get("hash")
+	params := url.Values{}
+	for _, key := range []string{"user_id", "game", "issued_at", "inline_message_id", "chat_id", "message_id"} {
+		if value, ok := values[key]; ok && len(value) == 1 {
+			params[key] = value
+		}
+	}
+
+	expected := s.sign(params)
+	if hash == "" || !hmac.Equal([]byte(hash), []byte(expected)) {
+		return GameSession{}, ErrGameURLInvalid
+	}
+
+	var session GameSession
+	var err error
+	if session.UserID, err = strconv.ParseInt(params.Get("user_id"), 10, 64); err != nil {
+		return GameSession{}, ErrGameURLInvalid
+	}
+	session.GameShortName = params.Get("game")
+
+	issuedAt, err := strconv.ParseInt(params.Get("issued_at"), 10, 64)
+	if err != nil {
+		return GameSession{}, ErrGameURLInvalid
+	}
+	session.IssuedAt = time.Unix(issuedAt, 0)
+	if s.MaxAge > 0 && time.Since(session.IssuedAt) > s.MaxAge {
+		return GameSession{}, ErrGameURLInvalid
+	}
+
+	if session.InlineMessageID = params.Get("inline_message_id"); session.InlineMessageID == "" {
+		if session.ChatID, err = strconv.ParseInt(params.Get("chat_id"), 10, 64); err != nil {
+			return GameSession{}, ErrGameURLInvalid
+		}
+		if session.MessageID, err = strconv.Atoi(params.Get("message_id")); err != nil {
+			return GameSession{}, ErrGameURLInvalid
+		}
+	}
+
+	return session, nil
+}
+
+// sign returns the hex-encoded HMAC-SHA256 of the sorted "name=value" lines of params.
+func (s GameURLSigner) sign(params url.Values) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		if key != "hash" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	lines := make([]string, len(keys))
+	for i, key := range keys {
+		lines[i] = key + "=" + params.Get(key)
+	}
+
+	h := hmac.New(sha256.New, s.Secret)
+	h.Write([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// AnswerGameCallbackQuery answers a game callback query with a signed URL that opens the game for the user.
+func (b *Bot) AnswerGameCallbackQuery(query CallbackQuery, signer GameURLSigner) error {
+	gameURL, err := signer.URL(query)
+	if err != nil {
+		return err
+	}
+
+	return b.AnswerCallbackQuery(AnswerCallbackQueryRequest{CallbackQueryID: query.ID, URL: &gameURL})
+}
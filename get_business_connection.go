@@ -0,0 +1,52 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetBusinessConnectionRequest represents a request to get information about the connection of the bot with a business account.
+//
+// See "getBusinessConnection" https://core.telegram.org/bots/api#getbusinessconnection
+type GetBusinessConnectionRequest struct {
+	// (Required) Unique identifier of the business connection.
+	BusinessConnectionID string `json:"business_connection_id"`
+}
+
+// GetBusinessConnection gets information about the connection of the bot with a business account. Returns a BusinessConnection object on success.
+//
+// See "getBusinessConnection" https://core.telegram.org/bots/api#getbusinessconnection
+func (b *Bot) GetBusinessConnection(request GetBusinessConnectionRequest) (BusinessConnection, error) {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return BusinessConnection{}, fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "getBusinessConnection", requestPayload)
+	if err != nil {
+		return BusinessConnection{}, err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      BusinessConnection `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return BusinessConnection{}, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return BusinessConnection{}, fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return response.Result, nil
+}
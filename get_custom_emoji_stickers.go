@@ -0,0 +1,52 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetCustomEmojiStickersRequest represents a request to get information about custom emoji stickers by their identifiers.
+//
+// See "getCustomEmojiStickers" https://core.telegram.org/bots/api#getcustomemojistickers
+type GetCustomEmojiStickersRequest struct {
+	// (Required) A JSON-serialized list of custom emoji identifiers. At most 200 custom emoji identifiers can be specified.
+	CustomEmojiIDs []string `json:"custom_emoji_ids"`
+}
+
+// GetCustomEmojiStickers gets information about custom emoji stickers by their identifiers. Returns an Array of Sticker objects.
+//
+// See "getCustomEmojiStickers" https://core.telegram.org/bots/api#getcustomemojistickers
+func (b *Bot) GetCustomEmojiStickers(request GetCustomEmojiStickersRequest) ([]Sticker, error) {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return nil, fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "getCustomEmojiStickers", requestPayload)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      []Sticker          `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return nil, fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return response.Result, nil
+}
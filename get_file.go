@@ -0,0 +1,81 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// GetFileRequest represents a request to get basic information about a file and prepare it for downloading.
+//
+// See "getFile" https://core.telegram.org/bots/api#getfile
+type GetFileRequest struct {
+	// (Required) File identifier to get information about.
+	FileID string `json:"file_id"`
+}
+
+// GetFile gets basic information about a file and prepares it for downloading. For the moment, bots can download files
+// of up to 20MB in size. The file can then be downloaded with DownloadFile. It is guaranteed that the file path will be valid for at least 1 hour.
+//
+// See "getFile" https://core.telegram.org/bots/api#getfile
+func (b *Bot) GetFile(request GetFileRequest) (File, error) {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return File{}, fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "getFile", requestPayload)
+	if err != nil {
+		return File{}, err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      File               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return File{}, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return File{}, fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return response.Result, nil
+}
+
+// DownloadFile downloads the contents of a file prepared with GetFile.
+//
+// See "File" https://core.telegram.org/bots/api#file
+func (b *Bot) DownloadFile(file File) ([]byte, error) {
+	if file.FilePath == nil {
+		return nil, fmt.Errorf("file %s has no file path, call GetFile first", file.FileID)
+	}
+
+	url := fmt.Sprintf(telegramFileEndpoint, b.Token, *file.FilePath)
+	httpResponse, err := b.client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("error sending GET request for file %s: %w", file.FileID, err)
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP status %s downloading file %s", httpResponse.Status, file.FileID)
+	}
+
+	contents, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file %s: %w", file.FileID, err)
+	}
+
+	return contents, nil
+}
@@ -0,0 +1,66 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetGameHighScoresRequest represents a request to get data for high score tables.
+//
+// See "getGameHighScores" https://core.telegram.org/bots/api#getgamehighscores
+type GetGameHighScoresRequest struct {
+	// (Required) Target user id.
+	UserID int64 `json:"user_id"`
+
+	// (Optional) Required if inline_message_id is not specified. Unique identifier for the target chat.
+	ChatID *int64 `json:"chat_id,omitempty"`
+
+	// (Optional) Required if inline_message_id is not specified. Identifier of the sent message.
+	MessageID *int `json:"message_id,omitempty"`
+
+	// (Optional) Required if chat_id and message_id are not specified. Identifier of the inline message.
+	InlineMessageID *string `json:"inline_message_id,omitempty"`
+}
+
+// GetGameHighScores gets data for high score tables. Will return the score of the specified user and several of their neighbors in a game.
+//
+// # Note
+//
+// This method will currently return scores for the target user, plus two of their closest neighbors on each side.
+// Will also return the top three users if the user and their neighbors are not among them. Please note that this behavior is subject to change.
+//
+// See "getGameHighScores" https://core.telegram.org/bots/api#getgamehighscores
+func (b *Bot) GetGameHighScores(request GetGameHighScoresRequest) ([]GameHighScore, error) {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return nil, fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "getGameHighScores", requestPayload)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      []GameHighScore    `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return nil, fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return response.Result, nil
+}
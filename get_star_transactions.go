@@ -0,0 +1,55 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetStarTransactionsRequest represents a request to get the bot's Telegram Star transactions.
+//
+// See "getStarTransactions" https://core.telegram.org/bots/api#getstartransactions
+type GetStarTransactionsRequest struct {
+	// (Optional) Number of transactions to skip in the response.
+	Offset *int `json:"offset,omitempty"`
+
+	// (Optional) The maximum number of transactions to be retrieved. Values between 1-100 are accepted. Defaults to 100.
+	Limit *int `json:"limit,omitempty"`
+}
+
+// GetStarTransactions returns the bot's Telegram Star transactions in chronological order.
+//
+// See "getStarTransactions" https://core.telegram.org/bots/api#getstartransactions
+func (b *Bot) GetStarTransactions(request GetStarTransactionsRequest) (StarTransactions, error) {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return StarTransactions{}, fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "getStarTransactions", requestPayload)
+	if err != nil {
+		return StarTransactions{}, err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      StarTransactions   `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return StarTransactions{}, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return StarTransactions{}, fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return response.Result, nil
+}
@@ -0,0 +1,52 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetStickerSetRequest represents a request to get a sticker set.
+//
+// See "getStickerSet" https://core.telegram.org/bots/api#getstickerset
+type GetStickerSetRequest struct {
+	// (Required) Name of the sticker set.
+	Name string `json:"name"`
+}
+
+// GetStickerSet gets a sticker set. On success, a StickerSet object is returned.
+//
+// See "getStickerSet" https://core.telegram.org/bots/api#getstickerset
+func (b *Bot) GetStickerSet(request GetStickerSetRequest) (StickerSet, error) {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return StickerSet{}, fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "getStickerSet", requestPayload)
+	if err != nil {
+		return StickerSet{}, err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      StickerSet         `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return StickerSet{}, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return StickerSet{}, fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return response.Result, nil
+}
@@ -2,11 +2,17 @@ package telegram
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 )
 
+// getUpdatesGracePeriod is how long a getUpdates request may take beyond its long polling timeout before it is abandoned,
+// e.g. because the connection was dropped without being closed.
+const getUpdatesGracePeriod = 10 * time.Second
+
 // GetUpdatesRequest represents a request to receive incoming updates using long polling.
 //
 // See "getUpdates" https://core.telegram.org/bots/api#getupdates
@@ -28,7 +34,7 @@ type GetUpdatesRequest struct {
 	// except chat_member, message_reaction, and message_reaction_count (default). If not specified, the previous setting will be used.
 	//
 	// Please note that this parameter doesn't affect updates created before the call to the getUpdates, so unwanted updates may be received for a short period of time.
-	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+	AllowedUpdates []UpdateType `json:"allowed_updates,omitempty"`
 }
 
 // GetUpdates sends a request to the Telegram API to retrieve incoming updates using long polling.
@@ -39,20 +45,53 @@ type GetUpdatesRequest struct {
 //
 // See "getUpdates" https://core.telegram.org/bots/api#getupdates
 func (b *Bot) getUpdates(request GetUpdatesRequest) ([]Update, error) {
+	rawUpdates, err := b.getRawUpdates(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]Update, len(rawUpdates))
+	for i, rawUpdate := range rawUpdates {
+		if err := json.Unmarshal(rawUpdate, &updates[i]); err != nil {
+			return nil, fmt.Errorf("error decoding update: %w", err)
+		}
+	}
+	return updates, nil
+}
+
+// getRawUpdates is getUpdates bound to ctx, returning the updates undecoded so that callers can decode them one at a time.
+// The HTTP client's timeout doesn't apply, so that long polling can wait for updates as long as request.Timeout allows;
+// the request is abandoned getUpdatesGracePeriod after that. Cancel ctx to stop waiting earlier.
+func (b *Bot) getRawUpdates(ctx context.Context, request GetUpdatesRequest) ([]json.RawMessage, error) {
+	timeout := getUpdatesGracePeriod
+	if request.Timeout != nil {
+		timeout += time.Duration(*request.Timeout) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	requestPayload := new(bytes.Buffer)
 	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
 		return nil, fmt.Errorf("error encoding request payload: %w", err)
 	}
 
-	httpResponse, err := b.sendRequest("POST", "application/json", "getUpdates", requestPayload)
+	httpRequest, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf(telegramEndpoint, b.Token, "getUpdates"), requestPayload)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error creating new POST request to getUpdates: %w", err)
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	client := *b.client
+	client.Timeout = 0
+	httpResponse, err := client.Do(httpRequest)
+	if err != nil {
+		return nil, fmt.Errorf("error sending POST request to getUpdates: %w", err)
 	}
 	defer httpResponse.Body.Close()
 
 	var response = struct {
 		Ok          bool               `json:"ok"`
-		Result      []Update           `json:"result"`
+		Result      []json.RawMessage  `json:"result"`
 		Description string             `json:"description"`
 		ErrorCode   int                `json:"error_code"`
 		Parameters  ResponseParameters `json:"parameters"`
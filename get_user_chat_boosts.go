@@ -0,0 +1,56 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetUserChatBoostsRequest represents a request to get the list of boosts added to a chat by a user.
+//
+// See "getUserChatBoosts" https://core.telegram.org/bots/api#getuserchatboosts
+type GetUserChatBoostsRequest struct {
+	// (Required) Unique identifier for the chat or username of the channel (in the format @channelusername).
+	ChatID interface{} `json:"chat_id"` // Using interface{} to allow both Integer and String types.
+
+	// (Required) Unique identifier of the target user.
+	UserID int64 `json:"user_id"`
+}
+
+// GetUserChatBoosts gets the list of boosts added to a chat by a user. Requires administrator rights in the chat.
+// Returns a UserChatBoosts object.
+//
+// See "getUserChatBoosts" https://core.telegram.org/bots/api#getuserchatboosts
+func (b *Bot) GetUserChatBoosts(request GetUserChatBoostsRequest) (UserChatBoosts, error) {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return UserChatBoosts{}, fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "getUserChatBoosts", requestPayload)
+	if err != nil {
+		return UserChatBoosts{}, err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      UserChatBoosts     `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return UserChatBoosts{}, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return UserChatBoosts{}, fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return response.Result, nil
+}
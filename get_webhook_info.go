@@ -35,7 +35,7 @@ type WebhookInfo struct {
 	MaxConnections int `json:"max_connections"`
 
 	// (Optional) A list of update types the bot is subscribed to. Defaults to all update types except chat_member.
-	AllowedUpdates []string `json:"allowed_updates"`
+	AllowedUpdates []UpdateType `json:"allowed_updates"`
 }
 
 // GetWebhookInfo retrieves the current status of the webhook.
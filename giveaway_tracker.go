@@ -0,0 +1,318 @@
+package telegram
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// Giveaway statuses reported by GiveawayTracker.
+const (
+	GiveawayStatusCreated         = "created"
+	GiveawayStatusAnnounced       = "announced"
+	GiveawayStatusWinnersSelected = "winners_selected"
+	GiveawayStatusCompleted       = "completed"
+	GiveawayStatusRefunded        = "refunded"
+)
+
+// GiveawayReport summarizes the lifecycle of a single giveaway.
+type GiveawayReport struct {
+	// Identifier of the chat the giveaway was posted in.
+	ChatID int64 `json:"chat_id"`
+
+	// Identifier of the message with the giveaway, 0 if the giveaway message wasn't seen.
+	GiveawayMessageID int `json:"giveaway_message_id"`
+
+	// One of the GiveawayStatus constants.
+	Status string `json:"status"`
+
+	// Unix time the giveaway message was posted, 0 if it wasn't seen.
+	AnnouncedAt int `json:"announced_at,omitempty"`
+
+	// Unix time when the winners were or will be selected.
+	WinnersSelectionDate int64 `json:"winners_selection_date,omitempty"`
+
+	// Number of users that were or will be selected as winners.
+	WinnerCount int `json:"winner_count"`
+
+	// Description of additional giveaway prize.
+	PrizeDescription string `json:"prize_description,omitempty"`
+
+	// Number of Telegram Stars split between the winners; for Telegram Star giveaways only.
+	PrizeStarCount int `json:"prize_star_count,omitempty"`
+
+	// Number of months the Telegram Premium subscription won from the giveaway is active for; for Telegram Premium giveaways only.
+	PremiumSubscriptionMonthCount int `json:"premium_subscription_month_count,omitempty"`
+
+	// Winners of the giveaway, if they are public.
+	Winners []User `json:"winners,omitempty"`
+
+	// Number of prizes that weren't distributed because there were not enough eligible users.
+	UnclaimedPrizeCount int `json:"unclaimed_prize_count"`
+
+	// True, if the giveaway was canceled because the payment for it was refunded.
+	WasRefunded bool `json:"was_refunded"`
+}
+
+// giveawayKey identifies a giveaway by its message.
+type giveawayKey struct {
+	chatID    int64
+	messageID int
+}
+
+// GiveawayTracker correlates the messages of a giveaway's lifecycle: the giveaway_created service message, the giveaway message
+// itself, the giveaway_winners message and the giveaway_completed service message. Winners and completions are matched to the
+// giveaway through the identifier of the giveaway message.
+type GiveawayTracker struct {
+	mu        sync.Mutex
+	giveaways map[giveawayKey]*GiveawayReport
+	created   map[int64]*GiveawayReport
+}
+
+// NewGiveawayTracker creates an empty tracker.
+func NewGiveawayTracker() *GiveawayTracker {
+	return &GiveawayTracker{
+		giveaways: make(map[giveawayKey]*GiveawayReport),
+		created:   make(map[int64]*GiveawayReport),
+	}
+}
+
+// Middleware returns a middleware that records giveaway messages before passing the update on.
+func (t *GiveawayTracker) Middleware() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(bot *Bot, update Update) error {
+			t.RecordUpdate(update)
+			return next(bot, update)
+		}
+	}
+}
+
+// RecordUpdate records the giveaway message carried by the update, if any.
+func (t *GiveawayTracker) RecordUpdate(update Update) {
+	switch {
+	case update.Message != nil:
+		t.RecordMessage(*update.Message)
+	case update.ChannelPost != nil:
+		t.RecordMessage(*update.ChannelPost)
+	}
+}
+
+// RecordMessage records a giveaway, giveaway_created, giveaway_winners or giveaway_completed message.
+// Other messages are ignored.
+func (t *GiveawayTracker) RecordMessage(message Message) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch {
+	case message.GiveawayCreated != nil:
+		// The giveaway message follows the service message, so the creation is attached to the chat's next giveaway.
+		report := &GiveawayReport{ChatID: message.Chat.ID, Status: GiveawayStatusCreated}
+		if message.GiveawayCreated.PrizeStarCount != nil {
+			report.PrizeStarCount = *message.GiveawayCreated.PrizeStarCount
+		}
+		t.created[message.Chat.ID] = report
+
+	case message.Giveaway != nil:
+		report := t.report(giveawayKey{message.Chat.ID, message.MessageID})
+		if created, ok := t.created[message.Chat.ID]; ok && report.Status == "" {
+			report.PrizeStarCount = created.PrizeStarCount
+			delete(t.created, message.Chat.ID)
+		}
+
+		giveaway := message.Giveaway
+		report.AnnouncedAt = message.Date
+		report.WinnersSelectionDate = giveaway.WinnersSelectionDate
+		report.WinnerCount = giveaway.WinnerCount
+		if giveaway.PrizeDescription != nil {
+			report.PrizeDescription = *giveaway.PrizeDescription
+		}
+		if giveaway.PrizeStarCount != nil {
+			report.PrizeStarCount = *giveaway.PrizeStarCount
+		}
+		if giveaway.PremiumSubscriptionMonthCount != nil {
+			report.PremiumSubscriptionMonthCount = *giveaway.PremiumSubscriptionMonthCount
+		}
+		report.setStatus(GiveawayStatusAnnounced)
+
+	case message.GiveawayWinners != nil:
+		winners := message.GiveawayWinners
+		report := t.report(giveawayKey{winners.Chat.ID, winners.GiveawayMessageID})
+		report.WinnersSelectionDate = winners.WinnersSelectionDate
+		report.WinnerCount = winners.WinnerCount
+		report.Winners = winners.Winners
+		if winners.PrizeDescription != nil {
+			report.PrizeDescription = *winners.PrizeDescription
+		}
+		if winners.PrizeStarCount != nil {
+			report.PrizeStarCount = *winners.PrizeStarCount
+		}
+		if winners.PremiumSubscriptionMonthCount != nil {
+			report.PremiumSubscriptionMonthCount = *winners.PremiumSubscriptionMonthCount
+		}
+		if winners.UnclaimedPrizeCount != nil {
+			report.UnclaimedPrizeCount = *winners.UnclaimedPrizeCount
+		}
+		if winners.WasRefunded != nil && *winners.WasRefunded {
+			report.WasRefunded = true
+			report.setStatus(GiveawayStatusRefunded)
+		} else {
+			report.setStatus(GiveawayStatusWinnersSelected)
+		}
+
+	case message.GiveawayCompleted != nil:
+		completed := message.GiveawayCompleted
+		giveawayMessage := completed.GiveawayMessage
+		if giveawayMessage == nil {
+			giveawayMessage = message.ReplyToMessage
+		}
+		if giveawayMessage == nil {
+			return
+		}
+
+		report := t.report(giveawayKey{giveawayMessage.Chat.ID, giveawayMessage.MessageID})
+		report.WinnerCount = completed.WinnerCount
+		if completed.UnclaimedPrizeCount != nil {
+			report.UnclaimedPrizeCount = *completed.UnclaimedPrizeCount
+		}
+		report.setStatus(GiveawayStatusCompleted)
+	}
+}
+
+// Report returns the report of the giveaway posted in the chat as the given message. A giveawayMessageID of 0 returns
+// the chat's giveaway that was created but whose giveaway message hasn't been seen yet.
+func (t *GiveawayTracker) Report(chatID int64, giveawayMessageID int) (GiveawayReport, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	report, ok := t.giveaways[giveawayKey{chatID, giveawayMessageID}]
+	if giveawayMessageID == 0 {
+		report, ok = t.created[chatID]
+	}
+	if !ok {
+		return GiveawayReport{}, false
+	}
+	return report.clone(), true
+}
+
+// Reports returns the reports of all tracked giveaways, ordered by chat and giveaway message. Giveaways whose giveaway message
+// hasn't been seen yet are reported with the status GiveawayStatusCreated and a GiveawayMessageID of 0.
+func (t *GiveawayTracker) Reports() []GiveawayReport {
+	t.mu.Lock()
+	reports := make([]GiveawayReport, 0, len(t.giveaways)+len(t.created))
+	for _, report := range t.giveaways {
+		reports = append(reports, report.clone())
+	}
+	for _, report := range t.created {
+		reports = append(reports, report.clone())
+	}
+	t.mu.Unlock()
+
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].ChatID != reports[j].ChatID {
+			return reports[i].ChatID < reports[j].ChatID
+		}
+		return reports[i].GiveawayMessageID < reports[j].GiveawayMessageID
+	})
+	return reports
+}
+
+// report returns the report of the giveaway, creating it if needed.
+func (t *GiveawayTracker) report(key giveawayKey) *GiveawayReport {
+	report, ok := t.giveaways[key]
+	if !ok {
+		report = &GiveawayReport{ChatID: key.chatID, GiveawayMessageID: key.messageID}
+		t.giveaways[key] = report
+	}
+	return report
+}
+
+// giveawayStatusOrder ranks statuses, so that messages received out of order don't move a giveaway back in its lifecycle.
+var giveawayStatusOrder = map[string]int{
+	GiveawayStatusCreated:         1,
+	GiveawayStatusAnnounced:       2,
+	GiveawayStatusWinnersSelected: 3,
+	GiveawayStatusCompleted:       4,
+	GiveawayStatusRefunded:        5,
+}
+
+// setStatus advances the report to status unless it is already further along.
+func (r *GiveawayReport) setStatus(status string) {
+	if giveawayStatusOrder[status] > giveawayStatusOrder[r.Status] {
+		r.Status = status
+	}
+}
+
+// clone returns a copy of the report that doesn't share the winners slice.
+func (r *GiveawayReport) clone() GiveawayReport {
+	report := *r
+	report.Winners = append([]User(nil), r.Winners...)
+	return report
+}
+
+// WriteGiveawayReportsJSON writes the reports to w as a JSON array.
+func WriteGiveawayReportsJSON(w io.Writer, reports ...GiveawayReport) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if reports == nil {
+		reports = []GiveawayReport{}
+	}
+	return encoder.Encode(reports)
+}
+
+// WriteGiveawayReportsCSV writes the reports to w as CSV with a header row. Each winner gets a row of their own;
+// giveaways without known winners get a single row with empty winner columns.
+func WriteGiveawayReportsCSV(w io.Writer, reports ...GiveawayReport) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{
+		"chat_id", "giveaway_message_id", "status", "announced_at", "winners_selection_date", "winner_count",
+		"prize_description", "prize_star_count", "premium_subscription_month_count", "unclaimed_prize_count", "was_refunded",
+		"winner_user_id", "winner_username", "winner_first_name", "winner_last_name",
+	}); err != nil {
+		return err
+	}
+
+	for _, report := range reports {
+		giveaway := []string{
+			strconv.FormatInt(report.ChatID, 10),
+			strconv.Itoa(report.GiveawayMessageID),
+			report.Status,
+			strconv.Itoa(report.AnnouncedAt),
+			strconv.FormatInt(report.WinnersSelectionDate, 10),
+			strconv.Itoa(report.WinnerCount),
+			report.PrizeDescription,
+			strconv.Itoa(report.PrizeStarCount),
+			strconv.Itoa(report.PremiumSubscriptionMonthCount),
+			strconv.Itoa(report.UnclaimedPrizeCount),
+			strconv.FormatBool(report.WasRefunded),
+		}
+
+		if len(report.Winners) == 0 {
+			if err := writer.Write(append(giveaway, "", "", "", "")); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, winner := range report.Winners {
+			row := append(append([]string(nil), giveaway...), strconv.FormatInt(winner.ID, 10),
+				stringValue(winner.Username), winner.FirstName, stringValue(winner.LastName))
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// stringValue returns the string s points to, or an empty string if s is nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
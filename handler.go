@@ -0,0 +1,15 @@
+package telegram
+
+// HandlerFunc processes a single incoming update.
+type HandlerFunc func(bot *Bot, update Update) error
+
+// Middleware wraps a HandlerFunc to run code before or after it.
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// Chain wraps handler with the given middlewares. The first middleware is the outermost one.
+func Chain(handler HandlerFunc, middlewares ...Middleware) HandlerFunc {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
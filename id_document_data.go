@@ -0,0 +1,13 @@
+package telegram
+
+// IdDocumentData represents the data of an identity document decrypted from a “passport”, “driver_license”, “identity_card”
+// or “internal_passport” Telegram Passport element.
+//
+// See "IdDocumentData" https://core.telegram.org/passport#iddocumentdata
+type IdDocumentData struct {
+	// (Required) Document number.
+	DocumentNo string `json:"document_no"`
+
+	// (Optional) Date of expiry, in DD.MM.YYYY format.
+	ExpiryDate *string `json:"expiry_date,omitempty"`
+}
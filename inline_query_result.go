@@ -0,0 +1,8 @@
+package telegram
+
+// InlineQueryResult represents one result of an inline query. It is implemented by InlineQueryResultArticle.
+//
+// See "InlineQueryResult" https://core.telegram.org/bots/api#inlinequeryresult
+type InlineQueryResult interface {
+	inlineQueryResult()
+}
@@ -0,0 +1,9 @@
+package telegram
+
+// InputMessageContent represents the content of a message to be sent as a result of an inline query.
+// It is implemented by InputTextMessageContent.
+//
+// See "InputMessageContent" https://core.telegram.org/bots/api#inputmessagecontent
+type InputMessageContent interface {
+	inputMessageContent()
+}
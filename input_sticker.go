@@ -0,0 +1,24 @@
+package telegram
+
+// InputSticker describes a sticker to be added to a sticker set.
+//
+// See "InputSticker" https://core.telegram.org/bots/api#inputsticker
+type InputSticker struct {
+	// (Required) The added sticker. Pass a file_id as a String to send a file that already exists on the Telegram servers, or pass
+	// an HTTP URL as a String for Telegram to get a file from the Internet. Animated and video stickers can't be uploaded via HTTP URL.
+	// Use UploadStickerFile to upload a new file and pass the returned file_id.
+	Sticker string `json:"sticker"`
+
+	// (Required) Format of the added sticker, must be one of “static” for a .WEBP or .PNG image, “animated” for a .TGS animation,
+	// “video” for a .WEBM video.
+	Format string `json:"format"`
+
+	// (Required) List of 1-20 emoji associated with the sticker.
+	EmojiList []string `json:"emoji_list"`
+
+	// (Optional) Position where the mask should be placed on faces. For “mask” stickers only.
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
+
+	// (Optional) List of 0-20 search keywords for the sticker with total length of up to 64 characters. For “regular” and “custom_emoji” stickers only.
+	Keywords []string `json:"keywords,omitempty"`
+}
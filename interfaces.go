@@ -1,18 +1,14 @@
 package telegram
 
-// MaybeInaccessibleMessage https://core.telegram.org/bots/api#maybeinaccessiblemessage
 // MessageOrigin https://core.telegram.org/bots/api#messageorigin
-// PaidMedia https://core.telegram.org/bots/api#paidmedia
 // BackgroundFill https://core.telegram.org/bots/api#backgroundfill
 // BackgroundType https://core.telegram.org/bots/api#backgroundtype
-// ChatMember https://core.telegram.org/bots/api#backgroundtypefill
-// ReactionType https://core.telegram.org/bots/api#reactiontype
 // BotCommandScope https://core.telegram.org/bots/api#botcommandscope
 // MenuButton https://core.telegram.org/bots/api#menubutton
-// ChatBoostSource https://core.telegram.org/bots/api#chatboostsource
 // InputMedia https://core.telegram.org/bots/api#inputmedia
 // InputPaidMedia https://core.telegram.org/bots/api#inputpaidmediaphoto
 
+type CallbackGame struct{}
 type ForumTopicClosed struct{}
 type ForumTopicReopened struct{}
 type GeneralForumTopicHidden struct{}
@@ -0,0 +1,355 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+const (
+	// maxInlineKeyboardRowSize is the maximum number of buttons in one row of an inline keyboard.
+	maxInlineKeyboardRowSize = 8
+
+	// maxInlineKeyboardButtons is the maximum number of buttons in an inline keyboard.
+	maxInlineKeyboardButtons = 100
+
+	// maxReplyKeyboardRowSize is the maximum number of buttons in one row of a reply keyboard.
+	maxReplyKeyboardRowSize = 12
+
+	// maxReplyKeyboardButtons is the maximum number of buttons in a reply keyboard.
+	maxReplyKeyboardButtons = 300
+)
+
+// InlineButtonData creates an inline keyboard button that sends data in a callback query when pressed.
+func InlineButtonData(text, data string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, CallbackData: &data}
+}
+
+// InlineButtonURL creates an inline keyboard button that opens url when pressed.
+func InlineButtonURL(text, url string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, URL: &url}
+}
+
+// InlineButtonWebApp creates an inline keyboard button that launches the Web App at url when pressed.
+func InlineButtonWebApp(text, url string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, WebApp: &WebAppInfo{URL: url}}
+}
+
+// InlineButtonLoginURL creates an inline keyboard button that authorizes the user on a website when pressed.
+func InlineButtonLoginURL(text string, loginURL LoginUrl) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, LoginURL: &loginURL}
+}
+
+// InlineButtonSwitchInlineQuery creates an inline keyboard button that lets the user choose a chat and inserts query there.
+func InlineButtonSwitchInlineQuery(text, query string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, SwitchInlineQuery: &query}
+}
+
+// InlineButtonSwitchInlineQueryCurrentChat creates an inline keyboard button that inserts query in the current chat.
+func InlineButtonSwitchInlineQueryCurrentChat(text, query string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, SwitchInlineQueryCurrentChat: &query}
+}
+
+// InlineButtonSwitchInlineQueryChosenChat creates an inline keyboard button that lets the user choose a chat of the given types.
+func InlineButtonSwitchInlineQueryChosenChat(text string, chosenChat SwitchInlineQueryChosenChat) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, SwitchInlineQueryChosenChat: &chosenChat}
+}
+
+// InlineButtonCopyText creates an inline keyboard button that copies copied to the clipboard when pressed.
+func InlineButtonCopyText(text, copied string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, CopyText: &CopyTextButton{Text: copied}}
+}
+
+// InlineButtonGame creates an inline keyboard button that launches the game of the message. It must be the first button in the first row.
+func InlineButtonGame(text string) InlineKeyboardButton {
+	return InlineKeyboardButton{Text: text, CallbackGame: &CallbackGame{}}
+}
+
+// InlineButtonPay creates a Pay button. It must be the first button in the first row of an invoice message.
+func InlineButtonPay(text string) InlineKeyboardButton {
+	pay := true
+	return InlineKeyboardButton{Text: text, Pay: &pay}
+}
+
+// Validate checks that the button has a label and exactly one of its optional fields set, within Telegram's limits.
+func (button InlineKeyboardButton) Validate() error {
+	if button.Text == "" {
+		return errors.New("inline keyboard button text is empty")
+	}
+
+	set := 0
+	for _, isSet := range []bool{
+		button.URL != nil,
+		button.CallbackData != nil,
+		button.WebApp != nil,
+		button.LoginURL != nil,
+		button.SwitchInlineQuery != nil,
+		button.SwitchInlineQueryCurrentChat != nil,
+		button.SwitchInlineQueryChosenChat != nil,
+		button.CopyText != nil,
+		button.CallbackGame != nil,
+		button.Pay != nil,
+	} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return fmt.Errorf("inline keyboard button %q must have exactly one optional field set, got %d", button.Text, set)
+	}
+
+	if button.CallbackData != nil && (len(*button.CallbackData) < 1 || len(*button.CallbackData) > maxCallbackDataLength) {
+		return fmt.Errorf("inline keyboard button %q callback data must be 1-%d bytes, got %d", button.Text, maxCallbackDataLength, len(*button.CallbackData))
+	}
+
+	if button.CopyText != nil {
+		if length := utf8.RuneCountInString(button.CopyText.Text); length < 1 || length > 256 {
+			return fmt.Errorf("inline keyboard button %q copied text must be 1-256 characters, got %d", button.Text, length)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks every button of the keyboard as well as the row and total button limits.
+func (markup InlineKeyboardMarkup) Validate() error {
+	total := 0
+	for i, row := range markup.InlineKeyboard {
+		if len(row) == 0 {
+			return fmt.Errorf("inline keyboard row %d is empty", i)
+		}
+		if len(row) > maxInlineKeyboardRowSize {
+			return fmt.Errorf("inline keyboard row %d has %d buttons, at most %d are allowed", i, len(row), maxInlineKeyboardRowSize)
+		}
+
+		for j, button := range row {
+			if err := button.Validate(); err != nil {
+				return fmt.Errorf("inline keyboard row %d, button %d: %w", i, j, err)
+			}
+			if (button.Pay != nil || button.CallbackGame != nil) && (i != 0 || j != 0) {
+				return fmt.Errorf("inline keyboard row %d, button %d: pay and game buttons must be the first button in the first row", i, j)
+			}
+		}
+
+		total += len(row)
+	}
+
+	if total > maxInlineKeyboardButtons {
+		return fmt.Errorf("inline keyboard has %d buttons, at most %d are allowed", total, maxInlineKeyboardButtons)
+	}
+
+	return nil
+}
+
+// InlineKeyboardBuilder assembles an InlineKeyboardMarkup row by row.
+type InlineKeyboardBuilder struct {
+	rows [][]InlineKeyboardButton
+}
+
+// NewInlineKeyboard creates an empty inline keyboard builder.
+func NewInlineKeyboard() *InlineKeyboardBuilder {
+	return &InlineKeyboardBuilder{}
+}
+
+// Row appends a row with the given buttons.
+func (b *InlineKeyboardBuilder) Row(buttons ...InlineKeyboardButton) *InlineKeyboardBuilder {
+	b.rows = append(b.rows, buttons)
+	return b
+}
+
+// Grid appends the buttons in rows of the given number of columns. The last row may be shorter.
+func (b *InlineKeyboardBuilder) Grid(columns int, buttons ...InlineKeyboardButton) *InlineKeyboardBuilder {
+	if columns < 1 {
+		columns = 1
+	}
+	for start := 0; start < len(buttons); start += columns {
+		end := min(start+columns, len(buttons))
+		b.rows = append(b.rows, buttons[start:end:end])
+	}
+	return b
+}
+
+// Build validates the keyboard and returns it.
+func (b *InlineKeyboardBuilder) Build() (InlineKeyboardMarkup, error) {
+	markup := InlineKeyboardMarkup{InlineKeyboard: b.rows}
+	if err := markup.Validate(); err != nil {
+		return InlineKeyboardMarkup{}, err
+	}
+	return markup, nil
+}
+
+// ReplyButton creates a plain reply keyboard button that sends its text when pressed.
+func ReplyButton(text string) KeyboardButton {
+	return KeyboardButton{Text: text}
+}
+
+// ReplyButtonContact creates a reply keyboard button that sends the user's phone number when pressed.
+func ReplyButtonContact(text string) KeyboardButton {
+	requestContact := true
+	return KeyboardButton{Text: text, RequestContact: &requestContact}
+}
+
+// ReplyButtonLocation creates a reply keyboard button that sends the user's current location when pressed.
+func ReplyButtonLocation(text string) KeyboardButton {
+	requestLocation := true
+	return KeyboardButton{Text: text, RequestLocation: &requestLocation}
+}
+
+// ReplyButtonPoll creates a reply keyboard button that asks the user to create a poll of the given type when pressed.
+func ReplyButtonPoll(text string, pollType KeyboardButtonPollType) KeyboardButton {
+	return KeyboardButton{Text: text, RequestPoll: &pollType}
+}
+
+// ReplyButtonWebApp creates a reply keyboard button that launches the Web App at url when pressed.
+func ReplyButtonWebApp(text, url string) KeyboardButton {
+	return KeyboardButton{Text: text, WebApp: &WebAppInfo{URL: url}}
+}
+
+// ReplyButtonRequestUsers creates a reply keyboard button that asks the user to pick users matching request when pressed.
+func ReplyButtonRequestUsers(text string, request KeyboardButtonRequestUsers) KeyboardButton {
+	return KeyboardButton{Text: text, RequestUsers: &request}
+}
+
+// ReplyButtonRequestChat creates a reply keyboard button that asks the user to pick a chat matching request when pressed.
+func ReplyButtonRequestChat(text string, request KeyboardButtonRequestChat) KeyboardButton {
+	return KeyboardButton{Text: text, RequestChat: &request}
+}
+
+// Validate checks that the button has a label and at most one of its optional fields set.
+func (button KeyboardButton) Validate() error {
+	if button.Text == "" {
+		return errors.New("keyboard button text is empty")
+	}
+
+	set := 0
+	for _, isSet := range []bool{
+		button.RequestUsers != nil,
+		button.RequestChat != nil,
+		button.RequestContact != nil,
+		button.RequestLocation != nil,
+		button.RequestPoll != nil,
+		button.WebApp != nil,
+	} {
+		if isSet {
+			set++
+		}
+	}
+	if set > 1 {
+		return fmt.Errorf("keyboard button %q must have at most one optional field set, got %d", button.Text, set)
+	}
+
+	if button.RequestUsers != nil && button.RequestUsers.MaxQuantity != nil {
+		if quantity := *button.RequestUsers.MaxQuantity; quantity < 1 || quantity > 10 {
+			return fmt.Errorf("keyboard button %q max quantity must be 1-10, got %d", button.Text, quantity)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks every button of the keyboard as well as the row and total button limits.
+func (markup ReplyKeyboardMarkup) Validate() error {
+	if len(markup.Keyboard) == 0 {
+		return errors.New("reply keyboard has no buttons")
+	}
+
+	total := 0
+	for i, row := range markup.Keyboard {
+		if len(row) == 0 {
+			return fmt.Errorf("reply keyboard row %d is empty", i)
+		}
+		if len(row) > maxReplyKeyboardRowSize {
+			return fmt.Errorf("reply keyboard row %d has %d buttons, at most %d are allowed", i, len(row), maxReplyKeyboardRowSize)
+		}
+
+		for j, button := range row {
+			if err := button.Validate(); err != nil {
+				return fmt.Errorf("reply keyboard row %d, button %d: %w", i, j, err)
+			}
+		}
+
+		total += len(row)
+	}
+
+	if total > maxReplyKeyboardButtons {
+		return fmt.Errorf("reply keyboard has %d buttons, at most %d are allowed", total, maxReplyKeyboardButtons)
+	}
+
+	if markup.InputFieldPlaceholder != nil {
+		if length := utf8.RuneCountInString(*markup.InputFieldPlaceholder); length < 1 || length > 64 {
+			return fmt.Errorf("reply keyboard input field placeholder must be 1-64 characters, got %d", length)
+		}
+	}
+
+	return nil
+}
+
+// ReplyKeyboardBuilder assembles a ReplyKeyboardMarkup row by row.
+type ReplyKeyboardBuilder struct {
+	markup ReplyKeyboardMarkup
+}
+
+// NewReplyKeyboard creates an empty reply keyboard builder.
+func NewReplyKeyboard() *ReplyKeyboardBuilder {
+	return &ReplyKeyboardBuilder{}
+}
+
+// Row appends a row with the given buttons.
+func (b *ReplyKeyboardBuilder) Row(buttons ...KeyboardButton) *ReplyKeyboardBuilder {
+	b.markup.Keyboard = append(b.markup.Keyboard, buttons)
+	return b
+}
+
+// Grid appends the buttons in rows of the given number of columns. The last row may be shorter.
+func (b *ReplyKeyboardBuilder) Grid(columns int, buttons ...KeyboardButton) *ReplyKeyboardBuilder {
+	if columns < 1 {
+		columns = 1
+	}
+	for start := 0; start < len(buttons); start += columns {
+		end := min(start+columns, len(buttons))
+		b.markup.Keyboard = append(b.markup.Keyboard, buttons[start:end:end])
+	}
+	return b
+}
+
+// Persistent requests clients to always show the keyboard when the regular keyboard is hidden.
+func (b *ReplyKeyboardBuilder) Persistent() *ReplyKeyboardBuilder {
+	isPersistent := true
+	b.markup.IsPersistent = &isPersistent
+	return b
+}
+
+// Resize requests clients to resize the keyboard vertically for optimal fit.
+func (b *ReplyKeyboardBuilder) Resize() *ReplyKeyboardBuilder {
+	resizeKeyboard := true
+	b.markup.ResizeKeyboard = &resizeKeyboard
+	return b
+}
+
+// OneTime requests clients to hide the keyboard as soon as it's been used.
+func (b *ReplyKeyboardBuilder) OneTime() *ReplyKeyboardBuilder {
+	oneTimeKeyboard := true
+	b.markup.OneTimeKeyboard = &oneTimeKeyboard
+	return b
+}
+
+// Placeholder sets the text shown in the input field while the keyboard is active.
+func (b *ReplyKeyboardBuilder) Placeholder(placeholder string) *ReplyKeyboardBuilder {
+	b.markup.InputFieldPlaceholder = &placeholder
+	return b
+}
+
+// Selective shows the keyboard only to the users targeted by the message.
+func (b *ReplyKeyboardBuilder) Selective() *ReplyKeyboardBuilder {
+	selective := true
+	b.markup.Selective = &selective
+	return b
+}
+
+// Build validates the keyboard and returns it.
+func (b *ReplyKeyboardBuilder) Build() (ReplyKeyboardMarkup, error) {
+	if err := b.markup.Validate(); err != nil {
+		return ReplyKeyboardMarkup{}, err
+	}
+	return b.markup, nil
+}
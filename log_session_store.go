@@ -0,0 +1,258 @@
+package telegram
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// minLogSessionCompaction is the number of log entries below which a LogSessionStore is never compacted automatically.
+const minLogSessionCompaction = 1024
+
+// LogSessionStore is a SessionStore that appends every write to a log file and keeps the current sessions in memory.
+// The log is replayed when the store is opened, so sessions survive restarts.
+//
+// The log is compacted, rewriting it with only the version counter and the unexpired sessions, when it has grown to more than
+// twice the number of sessions, or when Compact is called. If automatic compaction fails, the error is logged and compaction
+// is retried on the next write. The file must not be shared by several processes.
+type LogSessionStore struct {
+	// (Optional) Returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	path     string
+	mu       sync.Mutex
+	file     *os.File
+	sessions map[string]SessionRecord
+	version  uint64
+	entries  int
+}
+
+// logSessionEntry is a line of the log of a LogSessionStore: a written session, a deletion, or, at the start of a compacted
+// log, the version counter.
+type logSessionEntry struct {
+	Key string `json:"key,omitempty"`
+	SessionRecord
+	Deleted bool `json:"deleted,omitempty"`
+
+	// Version counter at the time the log was compacted. The versions of the sessions in the log may all be lower,
+	// e.g. if the session written last was deleted.
+	Counter uint64 `json:"counter,omitempty"`
+}
+
+// OpenLogSessionStore opens the store logging to the file at path, creating it if it doesn't exist, and replays the log.
+// An incomplete last entry, left by a crash while it was written, is discarded.
+func OpenLogSessionStore(path string) (*LogSessionStore, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("error opening session log: %w", err)
+	}
+
+	s := &LogSessionStore{path: path, file: file, sessions: make(map[string]SessionRecord)}
+	if err := s.replay(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// replay loads the sessions from the log and positions the file at the end of its last complete entry.
+func (s *LogSessionStore) replay() error {
+	reader := bufio.NewReader(s.file)
+	var offset int64
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// Anything after the last newline is an entry whose write didn't complete.
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error reading session log: %w", err)
+		}
+
+		var entry logSessionEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return fmt.Errorf("error decoding session log at offset %d: %w", offset, err)
+		}
+		s.apply(entry)
+		offset += int64(len(line))
+	}
+
+	if err := s.file.Truncate(offset); err != nil {
+		return fmt.Errorf("error truncating session log: %w", err)
+	}
+	if _, err := s.file.Seek(offset, io.SeekStart); err != nil {
+		return fmt.Errorf("error seeking session log: %w", err)
+	}
+	return nil
+}
+
+// apply applies a log entry to the in-memory sessions and the version counter.
+func (s *LogSessionStore) apply(entry logSessionEntry) {
+	s.entries++
+	s.version = max(s.version, entry.Version, entry.Counter)
+	switch {
+	case entry.Counter != 0:
+	case entry.Deleted:
+		delete(s.sessions, entry.Key)
+	default:
+		s.sessions[entry.Key] = entry.SessionRecord
+	}
+}
+
+// Get returns the session stored under key.
+func (s *LogSessionStore) Get(key string) (SessionRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.sessions[key]
+	if !ok || sessionExpired(record.ExpiresAt, s.now()) {
+		return SessionRecord{}, ErrSessionNotFound
+	}
+	record.Data = append([]byte(nil), record.Data...)
+	return record, nil
+}
+
+// Set stores data under key if the stored session has the given version.
+func (s *LogSessionStore) Set(key string, data []byte, version uint64, ttl time.Duration) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	stored, ok := s.sessions[key]
+	if version != currentSessionVersion(stored, ok, now) {
+		return 0, ErrSessionConflict
+	}
+
+	record := SessionRecord{Data: append([]byte(nil), data...), Version: s.version + 1, ExpiresAt: sessionExpiry(now, ttl)}
+	if err := s.append(logSessionEntry{Key: key, SessionRecord: record}); err != nil {
+		return 0, err
+	}
+	return record.Version, nil
+}
+
+// Delete removes the session stored under key.
+func (s *LogSessionStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sessions[key]; !ok {
+		return nil
+	}
+	return s.append(logSessionEntry{Key: key, Deleted: true})
+}
+
+// Compact rewrites the log with only the version counter and the unexpired sessions.
+func (s *LogSessionStore) Compact() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.compact()
+}
+
+// Close closes the log file. The store must not be used afterwards.
+func (s *LogSessionStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
+
+// append writes entry to the log, applies it and compacts the log if it has grown too large. The entry is written once append
+// returns without an error; compaction errors are only logged, as they don't affect the entry.
+func (s *LogSessionStore) append(entry logSessionEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("error encoding session %q: %w", entry.Key, err)
+	}
+
+	// A failed write may have written part of the line, which would corrupt the next entry, so the log is cut back to where
+	// it ended before.
+	offset, err := s.file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fmt.Errorf("error seeking session log: %w", err)
+	}
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		if truncateErr := s.file.Truncate(offset); truncateErr != nil {
+			err = errors.Join(err, truncateErr)
+		} else if _, seekErr := s.file.Seek(offset, io.SeekStart); seekErr != nil {
+			err = errors.Join(err, seekErr)
+		}
+		return fmt.Errorf("error writing session log: %w", err)
+	}
+	s.apply(entry)
+
+	if s.entries > minLogSessionCompaction && s.entries > 2*len(s.sessions) {
+		if err := s.compact(); err != nil {
+			log.Printf("telegram: %v", err)
+		}
+	}
+	return nil
+}
+
+// compact rewrites the log with only the version counter and the unexpired sessions. The new log is opened before it
+// replaces the old one, so the store keeps appending to the old log if anything fails.
+func (s *LogSessionStore) compact() error {
+	now := s.now()
+	var content bytes.Buffer
+	encoder := json.NewEncoder(&content)
+	header := 0
+	if s.version > 0 {
+		header = 1
+		if err := encoder.Encode(struct {
+			Counter uint64 `json:"counter"`
+		}{s.version}); err != nil {
+			return fmt.Errorf("error encoding session version: %w", err)
+		}
+	}
+
+	var expired []string
+	for key, record := range s.sessions {
+		if sessionExpired(record.ExpiresAt, now) {
+			expired = append(expired, key)
+			continue
+		}
+		if err := encoder.Encode(logSessionEntry{Key: key, SessionRecord: record}); err != nil {
+			return fmt.Errorf("error encoding session %q: %w", key, err)
+		}
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("error compacting session log: %w", err)
+	}
+	if _, err := file.Write(content.Bytes()); err == nil {
+		err = file.Sync()
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), s.path)
+	}
+	if err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return fmt.Errorf("error compacting session log: %w", err)
+	}
+
+	for _, key := range expired {
+		delete(s.sessions, key)
+	}
+	s.file.Close()
+	s.file = file
+	s.entries = header + len(s.sessions)
+	return nil
+}
+
+// now returns the current time.
+func (s *LogSessionStore) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,38 @@
+package telegram
+
+// LoginData represents the authorization data Telegram passes to a website through the Telegram Login Widget or
+// a login_url inline keyboard button.
+//
+// See "Receiving authorization data" https://core.telegram.org/widgets/login#receiving-authorization-data
+type LoginData struct {
+	// (Required) Unique identifier for the user.
+	ID int64 `json:"id"`
+
+	// (Required) User's first name.
+	FirstName string `json:"first_name"`
+
+	// (Optional) User's last name.
+	LastName *string `json:"last_name,omitempty"`
+
+	// (Optional) User's username.
+	Username *string `json:"username,omitempty"`
+
+	// (Optional) URL of the user's profile photo.
+	PhotoURL *string `json:"photo_url,omitempty"`
+
+	// (Required) Unix time when the authorization was granted.
+	AuthDate int64 `json:"auth_date"`
+
+	// (Required) A hash of all passed fields, which the website can use to check their validity.
+	Hash string `json:"hash"`
+}
+
+// User returns the authorized user mapped to the User type. Fields the Login Widget doesn't provide are left empty.
+func (d LoginData) User() User {
+	return User{
+		ID:        d.ID,
+		FirstName: d.FirstName,
+		LastName:  d.LastName,
+		Username:  d.Username,
+	}
+}
@@ -0,0 +1,133 @@
+package telegram
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ErrLoginDataInvalid is returned when Login Widget authorization data is malformed or its hash doesn't match.
+var ErrLoginDataInvalid = errors.New("login data is malformed or has an invalid hash")
+
+// ErrLoginDataExpired is returned when Login Widget authorization data is older than the allowed age.
+var ErrLoginDataExpired = errors.New("login data has expired")
+
+// loginDataFields are the authorization fields Telegram signs. A login_url button adds them to the bot's own URL, which may
+// carry further query parameters that are not part of the data-check-string.
+var loginDataFields = []string{"id", "first_name", "last_name", "username", "photo_url", "auth_date"}
+
+// DefaultLoginMaxAge is the authorization age LoginCallbackHandler accepts when MaxAge is not set.
+const DefaultLoginMaxAge = 24 * time.Hour
+
+// VerifyLoginData checks the hash of the authorization data received from the Telegram Login Widget or a login_url button
+// and returns it parsed. Authorization data older than maxAge is rejected; a maxAge of zero or less disables the check.
+//
+// The hash is the hex-encoded HMAC-SHA256 of the data-check-string, keyed with SHA-256 of the bot token. Only the authorization
+// fields are signed, so other query parameters of the redirect URL are ignored.
+//
+// See "Checking authorization" https://core.telegram.org/widgets/login#checking-authorization
+func (b *Bot) VerifyLoginData(values url.Values, maxAge time.Duration) (LoginData, error) {
+	hash, err := hex.DecodeString(values.Get("hash"))
+	if err != nil || len(hash) == 0 {
+		return LoginData{}, ErrLoginDataInvalid
+	}
+
+	secretKey := sha256.Sum256([]byte(b.Token))
+	expected := hmac.New(sha256.New, secretKey[:])
+	signed := make(url.Values, len(loginDataFields))
+	for _, key := range loginDataFields {
+		if values.Has(key) {
+			signed[key] = values[key]
+		}
+	}
+	expected.Write([]byte(dataCheckString(signed)))
+	if !hmac.Equal(hash, expected.Sum(nil)) {
+		return LoginData{}, ErrLoginDataInvalid
+	}
+
+	id, err := strconv.ParseInt(values.Get("id"), 10, 64)
+	if err != nil {
+		return LoginData{}, fmt.Errorf("%w: invalid id", ErrLoginDataInvalid)
+	}
+
+	authDate, err := strconv.ParseInt(values.Get("auth_date"), 10, 64)
+	if err != nil {
+		return LoginData{}, fmt.Errorf("%w: invalid auth_date", ErrLoginDataInvalid)
+	}
+	if maxAge > 0 && time.Since(time.Unix(authDate, 0)) > maxAge {
+		return LoginData{}, ErrLoginDataExpired
+	}
+
+	data := LoginData{
+		ID:        id,
+		FirstName: values.Get("first_name"),
+		AuthDate:  authDate,
+		Hash:      values.Get("hash"),
+	}
+
+	optionalString := func(key string) *string {
+		if !values.Has(key) {
+			return nil
+		}
+		value := values.Get(key)
+		return &value
+	}
+	data.LastName = optionalString("last_name")
+	data.Username = optionalString("username")
+	data.PhotoURL = optionalString("photo_url")
+
+	return data, nil
+}
+
+// LoginCallbackHandler is an http.Handler for the URL Telegram redirects to after a user signs in through the Login Widget
+// (data-auth-url) or a login_url button. It verifies the authorization data in the query string and passes it on to OnLogin.
+type LoginCallbackHandler struct {
+	// (Required) Bot whose token signs the authorization data.
+	Bot *Bot
+
+	// (Optional) Maximum accepted age of the authorization data. Defaults to DefaultLoginMaxAge; a negative value disables the check.
+	MaxAge time.Duration
+
+	// (Required) Called with the verified authorization data. It is responsible for establishing the session and writing the response,
+	// typically a redirect.
+	OnLogin func(w http.ResponseWriter, r *http.Request, data LoginData)
+
+	// (Optional) Called when the authorization data can't be verified. Defaults to responding with 401 Unauthorized.
+	OnError func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// ServeHTTP implements http.Handler.
+func (h *LoginCallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.Bot == nil || h.OnLogin == nil {
+		err := errors.New("login callback handler requires Bot and OnLogin")
+		if h.OnError != nil {
+			h.OnError(w, r, err)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	maxAge := h.MaxAge
+	if maxAge == 0 {
+		maxAge = DefaultLoginMaxAge
+	}
+
+	data, err := h.Bot.VerifyLoginData(r.URL.Query(), maxAge)
+	if err != nil {
+		if h.OnError != nil {
+			h.OnError(w, r, err)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	h.OnLogin(w, r, data)
+}
@@ -0,0 +1,67 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MaybeInaccessibleMessage describes a message that can be inaccessible to the bot. It can be one of
+// Message or InaccessibleMessage; exactly one of the fields is set after decoding.
+//
+// See "MaybeInaccessibleMessage" https://core.telegram.org/bots/api#maybeinaccessiblemessage
+type MaybeInaccessibleMessage struct {
+	// Set if the message is accessible to the bot.
+	Message *Message
+
+	// Set if the message was deleted or is otherwise inaccessible to the bot.
+	InaccessibleMessage *InaccessibleMessage
+}
+
+// UnmarshalJSON decodes either a Message or an InaccessibleMessage, using the date field to tell them apart.
+func (m *MaybeInaccessibleMessage) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Date int `json:"date"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return fmt.Errorf("error decoding maybe inaccessible message: %w", err)
+	}
+
+	*m = MaybeInaccessibleMessage{}
+	if probe.Date == 0 {
+		m.InaccessibleMessage = new(InaccessibleMessage)
+		return json.Unmarshal(data, m.InaccessibleMessage)
+	}
+
+	m.Message = new(Message)
+	return json.Unmarshal(data, m.Message)
+}
+
+// MarshalJSON encodes whichever of the two variants is set.
+func (m MaybeInaccessibleMessage) MarshalJSON() ([]byte, error) {
+	if m.Message != nil {
+		return json.Marshal(m.Message)
+	}
+	return json.Marshal(m.InaccessibleMessage)
+}
+
+// Chat returns the chat the message belongs to.
+func (m MaybeInaccessibleMessage) Chat() Chat {
+	if m.Message != nil {
+		return m.Message.Chat
+	}
+	if m.InaccessibleMessage != nil {
+		return m.InaccessibleMessage.Chat
+	}
+	return Chat{}
+}
+
+// MessageID returns the unique message identifier inside the chat.
+func (m MaybeInaccessibleMessage) MessageID() int {
+	if m.Message != nil {
+		return m.Message.MessageID
+	}
+	if m.InaccessibleMessage != nil {
+		return m.InaccessibleMessage.MessageID
+	}
+	return 0
+}
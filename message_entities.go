@@ -0,0 +1,120 @@
+package telegram
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// EntityText returns the part of the message text, or of its caption if the message has no text, covered by entity.
+// Offsets are counted in UTF-16 code units, as Telegram does.
+func (m Message) EntityText(entity MessageEntity) string {
+	text, _ := m.formattedText()
+	units := utf16.Encode([]rune(text))
+
+	start := min(max(entity.Offset, 0), len(units))
+	end := min(max(start+entity.Length, start), len(units))
+	return string(utf16.Decode(units[start:end]))
+}
+
+// Command returns the name of the bot command the message starts with, without the leading slash and the “@botusername”
+// suffix, e.g. “start” for “/start@jobs_bot payload”. It returns an empty string if the message doesn't start with a command.
+func (m Message) Command() string {
+	command, _ := m.commandWithTarget()
+	return command
+}
+
+// CommandTarget returns the username of the bot the command is addressed to, as in “/start@jobs_bot”, or an empty string
+// if the command has no “@botusername” suffix or the message doesn't start with a command.
+func (m Message) CommandTarget() string {
+	_, target := m.commandWithTarget()
+	return target
+}
+
+// CommandArgs returns the text after the bot command the message starts with, with surrounding whitespace removed.
+// It returns an empty string if the message doesn't start with a command.
+func (m Message) CommandArgs() string {
+	entity, ok := m.commandEntity()
+	if !ok {
+		return ""
+	}
+
+	text, _ := m.formattedText()
+	units := utf16.Encode([]rune(text))
+	return strings.TrimSpace(string(utf16.Decode(units[min(entity.Length, len(units)):])))
+}
+
+// Mentions returns the @usernames mentioned in the message, including the “@”.
+func (m Message) Mentions() []string {
+	return m.entityTexts("mention")
+}
+
+// TextMentions returns the users mentioned in the message by name, which is how users without usernames are mentioned.
+func (m Message) TextMentions() []User {
+	var users []User
+	for _, entity := range m.entities() {
+		if entity.Type == "text_mention" && entity.User != nil {
+			users = append(users, *entity.User)
+		}
+	}
+	return users
+}
+
+// Hashtags returns the hashtags in the message, including the “#”.
+func (m Message) Hashtags() []string {
+	return m.entityTexts("hashtag")
+}
+
+// URLs returns the URLs that appear in the message text.
+func (m Message) URLs() []string {
+	return m.entityTexts("url")
+}
+
+// TextLinks returns the targets of the clickable text links in the message.
+func (m Message) TextLinks() []string {
+	var urls []string
+	for _, entity := range m.entities() {
+		if entity.Type == "text_link" && entity.URL != nil {
+			urls = append(urls, *entity.URL)
+		}
+	}
+	return urls
+}
+
+// entities returns the entities of the message text, or of its caption if the message has no text.
+func (m Message) entities() []MessageEntity {
+	_, entities := m.formattedText()
+	return entities
+}
+
+// entityTexts returns the text covered by each entity of the given type.
+func (m Message) entityTexts(entityType string) []string {
+	var texts []string
+	for _, entity := range m.entities() {
+		if entity.Type == entityType {
+			texts = append(texts, m.EntityText(entity))
+		}
+	}
+	return texts
+}
+
+// commandEntity returns the bot_command entity at the start of the message.
+func (m Message) commandEntity() (MessageEntity, bool) {
+	for _, entity := range m.entities() {
+		if entity.Type == "bot_command" && entity.Offset == 0 {
+			return entity, true
+		}
+	}
+	return MessageEntity{}, false
+}
+
+// commandWithTarget splits the command the message starts with into its name and the username it is addressed to.
+func (m Message) commandWithTarget() (string, string) {
+	entity, ok := m.commandEntity()
+	if !ok {
+		return "", ""
+	}
+
+	command := strings.TrimPrefix(m.EntityText(entity), "/")
+	name, target, _ := strings.Cut(command, "@")
+	return name, target
+}
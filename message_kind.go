@@ -0,0 +1,229 @@
+package telegram
+
+// MessageKind identifies the content of a message. Its values are the names of the corresponding Message fields,
+// e.g. “photo” or “new_chat_members”.
+type MessageKind string
+
+// Kinds of message content.
+const (
+	MessageKindUnknown                       MessageKind = ""
+	MessageKindText                          MessageKind = "text"
+	MessageKindAnimation                     MessageKind = "animation"
+	MessageKindAudio                         MessageKind = "audio"
+	MessageKindDocument                      MessageKind = "document"
+	MessageKindPaidMedia                     MessageKind = "paid_media"
+	MessageKindPhoto                         MessageKind = "photo"
+	MessageKindSticker                       MessageKind = "sticker"
+	MessageKindStory                         MessageKind = "story"
+	MessageKindVideo                         MessageKind = "video"
+	MessageKindVideoNote                     MessageKind = "video_note"
+	MessageKindVoice                         MessageKind = "voice"
+	MessageKindContact                       MessageKind = "contact"
+	MessageKindDice                          MessageKind = "dice"
+	MessageKindGame                          MessageKind = "game"
+	MessageKindPoll                          MessageKind = "poll"
+	MessageKindVenue                         MessageKind = "venue"
+	MessageKindLocation                      MessageKind = "location"
+	MessageKindInvoice                       MessageKind = "invoice"
+	MessageKindNewChatMembers                MessageKind = "new_chat_members"
+	MessageKindLeftChatMember                MessageKind = "left_chat_member"
+	MessageKindNewChatTitle                  MessageKind = "new_chat_title"
+	MessageKindNewChatPhoto                  MessageKind = "new_chat_photo"
+	MessageKindDeleteChatPhoto               MessageKind = "delete_chat_photo"
+	MessageKindGroupChatCreated              MessageKind = "group_chat_created"
+	MessageKindSupergroupChatCreated         MessageKind = "supergroup_chat_created"
+	MessageKindChannelChatCreated            MessageKind = "channel_chat_created"
+	MessageKindMessageAutoDeleteTimerChanged MessageKind = "message_auto_delete_timer_changed"
+	MessageKindMigrateToChatID               MessageKind = "migrate_to_chat_id"
+	MessageKindMigrateFromChatID             MessageKind = "migrate_from_chat_id"
+	MessageKindPinnedMessage                 MessageKind = "pinned_message"
+	MessageKindSuccessfulPayment             MessageKind = "successful_payment"
+	MessageKindRefundedPayment               MessageKind = "refunded_payment"
+	MessageKindUsersShared                   MessageKind = "users_shared"
+	MessageKindChatShared                    MessageKind = "chat_shared"
+	MessageKindConnectedWebsite              MessageKind = "connected_website"
+	MessageKindWriteAccessAllowed            MessageKind = "write_access_allowed"
+	MessageKindPassportData                  MessageKind = "passport_data"
+	MessageKindProximityAlertTriggered       MessageKind = "proximity_alert_triggered"
+	MessageKindBoostAdded                    MessageKind = "boost_added"
+	MessageKindChatBackgroundSet             MessageKind = "chat_background_set"
+	MessageKindForumTopicCreated             MessageKind = "forum_topic_created"
+	MessageKindForumTopicEdited              MessageKind = "forum_topic_edited"
+	MessageKindForumTopicClosed              MessageKind = "forum_topic_closed"
+	MessageKindForumTopicReopened            MessageKind = "forum_topic_reopened"
+	MessageKindGeneralForumTopicHidden       MessageKind = "general_forum_topic_hidden"
+	MessageKindGeneralForumTopicUnhidden     MessageKind = "general_forum_topic_unhidden"
+	MessageKindGiveawayCreated               MessageKind = "giveaway_created"
+	MessageKindGiveaway                      MessageKind = "giveaway"
+	MessageKindGiveawayWinners               MessageKind = "giveaway_winners"
+	MessageKindGiveawayCompleted             MessageKind = "giveaway_completed"
+	MessageKindVideoChatScheduled            MessageKind = "video_chat_scheduled"
+	MessageKindVideoChatStarted              MessageKind = "video_chat_started"
+	MessageKindVideoChatEnded                MessageKind = "video_chat_ended"
+	MessageKindVideoChatParticipantsInvited  MessageKind = "video_chat_participants_invited"
+	MessageKindWebAppData                    MessageKind = "web_app_data"
+)
+
+// Kind returns the kind of content the message carries. Messages with an animation also carry a document, and venues
+// also carry a location; they are reported as MessageKindAnimation and MessageKindVenue respectively.
+func (m Message) Kind() MessageKind {
+	switch {
+	case m.Text != nil:
+		return MessageKindText
+	case m.Animation != nil:
+		return MessageKindAnimation
+	case m.Audio != nil:
+		return MessageKindAudio
+	case m.Document != nil:
+		return MessageKindDocument
+	case m.PaidMedia != nil:
+		return MessageKindPaidMedia
+	case len(m.Photo) > 0:
+		return MessageKindPhoto
+	case m.Sticker != nil:
+		return MessageKindSticker
+	case m.Story != nil:
+		return MessageKindStory
+	case m.Video != nil:
+		return MessageKindVideo
+	case m.VideoNote != nil:
+		return MessageKindVideoNote
+	case m.Voice != nil:
+		return MessageKindVoice
+	case m.Contact != nil:
+		return MessageKindContact
+	case m.Dice != nil:
+		return MessageKindDice
+	case m.Game != nil:
+		return MessageKindGame
+	case m.Poll != nil:
+		return MessageKindPoll
+	case m.Venue != nil:
+		return MessageKindVenue
+	case m.Location != nil:
+		return MessageKindLocation
+	case m.Invoice != nil:
+		return MessageKindInvoice
+	case len(m.NewChatMembers) > 0:
+		return MessageKindNewChatMembers
+	case m.LeftChatMember != nil:
+		return MessageKindLeftChatMember
+	case m.NewChatTitle != nil:
+		return MessageKindNewChatTitle
+	case len(m.NewChatPhoto) > 0:
+		return MessageKindNewChatPhoto
+	case m.DeleteChatPhoto != nil:
+		return MessageKindDeleteChatPhoto
+	case m.GroupChatCreated != nil:
+		return MessageKindGroupChatCreated
+	case m.SupergroupChatCreated != nil:
+		return MessageKindSupergroupChatCreated
+	case m.ChannelChatCreated != nil:
+		return MessageKindChannelChatCreated
+	case m.MessageAutoDeleteTimerChanged != nil:
+		return MessageKindMessageAutoDeleteTimerChanged
+	case m.MigrateToChatID != nil:
+		return MessageKindMigrateToChatID
+	case m.MigrateFromChatID != nil:
+		return MessageKindMigrateFromChatID
+	case m.PinnedMessage != nil:
+		return MessageKindPinnedMessage
+	case m.SuccessfulPayment != nil:
+		return MessageKindSuccessfulPayment
+	case m.RefundedPayment != nil:
+		return MessageKindRefundedPayment
+	case m.UsersShared != nil:
+		return MessageKindUsersShared
+	case m.ChatShared != nil:
+		return MessageKindChatShared
+	case m.ConnectedWebsite != nil:
+		return MessageKindConnectedWebsite
+	case m.WriteAccessAllowed != nil:
+		return MessageKindWriteAccessAllowed
+	case m.PassportData != nil:
+		return MessageKindPassportData
+	case m.ProximityAlertTriggered != nil:
+		return MessageKindProximityAlertTriggered
+	case m.BoostAdded != nil:
+		return MessageKindBoostAdded
+	case m.ChatBackgroundSet != nil:
+		return MessageKindChatBackgroundSet
+	case m.ForumTopicCreated != nil:
+		return MessageKindForumTopicCreated
+	case m.ForumTopicEdited != nil:
+		return MessageKindForumTopicEdited
+	case m.ForumTopicClosed != nil:
+		return MessageKindForumTopicClosed
+	case m.ForumTopicReopened != nil:
+		return MessageKindForumTopicReopened
+	case m.GeneralForumTopicHidden != nil:
+		return MessageKindGeneralForumTopicHidden
+	case m.GeneralForumTopicUnhidden != nil:
+		return MessageKindGeneralForumTopicUnhidden
+	case m.GiveawayCreated != nil:
+		return MessageKindGiveawayCreated
+	case m.Giveaway != nil:
+		return MessageKindGiveaway
+	case m.GiveawayWinners != nil:
+		return MessageKindGiveawayWinners
+	case m.GiveawayCompleted != nil:
+		return MessageKindGiveawayCompleted
+	case m.VideoChatScheduled != nil:
+		return MessageKindVideoChatScheduled
+	case m.VideoChatStarted != nil:
+		return MessageKindVideoChatStarted
+	case m.VideoChatEnded != nil:
+		return MessageKindVideoChatEnded
+	case m.VideoChatParticipantsInvited != nil:
+		return MessageKindVideoChatParticipantsInvited
+	case m.WebAppData != nil:
+		return MessageKindWebAppData
+	default:
+		return MessageKindUnknown
+	}
+}
+
+// IsService reports whether the message is a service message, such as a member joining or a forum topic being created,
+// rather than content sent by a user.
+func (m Message) IsService() bool {
+	return serviceMessageKinds[m.Kind()]
+}
+
+// serviceMessageKinds lists the kinds of service messages.
+var serviceMessageKinds = map[MessageKind]bool{
+	MessageKindNewChatMembers:                true,
+	MessageKindLeftChatMember:                true,
+	MessageKindNewChatTitle:                  true,
+	MessageKindNewChatPhoto:                  true,
+	MessageKindDeleteChatPhoto:               true,
+	MessageKindGroupChatCreated:              true,
+	MessageKindSupergroupChatCreated:         true,
+	MessageKindChannelChatCreated:            true,
+	MessageKindMessageAutoDeleteTimerChanged: true,
+	MessageKindMigrateToChatID:               true,
+	MessageKindMigrateFromChatID:             true,
+	MessageKindPinnedMessage:                 true,
+	MessageKindSuccessfulPayment:             true,
+	MessageKindRefundedPayment:               true,
+	MessageKindUsersShared:                   true,
+	MessageKindChatShared:                    true,
+	MessageKindConnectedWebsite:              true,
+	MessageKindWriteAccessAllowed:            true,
+	MessageKindPassportData:                  true,
+	MessageKindProximityAlertTriggered:       true,
+	MessageKindBoostAdded:                    true,
+	MessageKindChatBackgroundSet:             true,
+	MessageKindForumTopicCreated:             true,
+	MessageKindForumTopicEdited:              true,
+	MessageKindForumTopicClosed:              true,
+	MessageKindForumTopicReopened:            true,
+	MessageKindGeneralForumTopicHidden:       true,
+	MessageKindGeneralForumTopicUnhidden:     true,
+	MessageKindGiveawayCreated:               true,
+	MessageKindGiveawayCompleted:             true,
+	MessageKindVideoChatScheduled:            true,
+	MessageKindVideoChatStarted:              true,
+	MessageKindVideoChatEnded:                true,
+	MessageKindVideoChatParticipantsInvited:  true,
+	MessageKindWebAppData:                    true,
+}
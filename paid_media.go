@@ -0,0 +1,59 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PaidMedia describes paid media. It can be one of PaidMediaPreview, PaidMediaPhoto or PaidMediaVideo;
+// exactly one of the fields is set after decoding. Media of unknown types are decoded into Unknown.
+//
+// See "PaidMedia" https://core.telegram.org/bots/api#paidmedia
+type PaidMedia struct {
+	Preview *PaidMediaPreview
+	Photo   *PaidMediaPhoto
+	Video   *PaidMediaVideo
+	Unknown *PaidMediaUnknown
+}
+
+// UnmarshalJSON decodes the variant named by the type field.
+func (m *PaidMedia) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return fmt.Errorf("error decoding paid media: %w", err)
+	}
+
+	*m = PaidMedia{}
+	switch probe.Type {
+	case "preview":
+		m.Preview = new(PaidMediaPreview)
+		return json.Unmarshal(data, m.Preview)
+	case "photo":
+		m.Photo = new(PaidMediaPhoto)
+		return json.Unmarshal(data, m.Photo)
+	case "video":
+		m.Video = new(PaidMediaVideo)
+		return json.Unmarshal(data, m.Video)
+	default:
+		m.Unknown = &PaidMediaUnknown{Type: probe.Type, Raw: rawVariant(data)}
+		return nil
+	}
+}
+
+// MarshalJSON encodes whichever variant is set.
+func (m PaidMedia) MarshalJSON() ([]byte, error) {
+	switch {
+	case m.Preview != nil:
+		return json.Marshal(m.Preview)
+	case m.Photo != nil:
+		return json.Marshal(m.Photo)
+	case m.Video != nil:
+		return json.Marshal(m.Video)
+	case m.Unknown != nil:
+		return json.Marshal(m.Unknown)
+	default:
+		return nil, fmt.Errorf("paid media has no variant set")
+	}
+}
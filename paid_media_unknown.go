@@ -0,0 +1,19 @@
+package telegram
+
+import "encoding/json"
+
+// PaidMediaUnknown represents paid media of a type that isn't described by this package.
+type PaidMediaUnknown struct {
+	// (Required) Type of the paid media.
+	Type string `json:"type"`
+
+	// (Required) The paid media object as received.
+	Raw json.RawMessage `json:"-"`
+}
+
+// MarshalJSON encodes Raw, or only the type if Raw is empty.
+func (m PaidMediaUnknown) MarshalJSON() ([]byte, error) {
+	return marshalRawVariant(m.Raw, struct {
+		Type string `json:"type"`
+	}{m.Type})
+}
@@ -0,0 +1,241 @@
+package telegram
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// ErrPassportDataInvalid is returned when Telegram Passport data fails decryption or its hash doesn't match.
+var ErrPassportDataInvalid = errors.New("passport data failed decryption or verification")
+
+// DecryptedPassportElement is a Telegram Passport element with its data decrypted into the type matching the element type.
+// Files are not downloaded; decrypt their contents with PassportDecryptor.DecryptFile using the credentials in Credentials.
+type DecryptedPassportElement struct {
+	// Element as received from Telegram.
+	Element EncryptedPassportElement
+
+	// Credentials for the element's data and files. Nil for “phone_number” and “email” elements.
+	Credentials *SecureValue
+
+	// Set for “personal_details” elements.
+	PersonalDetails *PersonalDetails
+
+	// Set for “passport”, “driver_license”, “identity_card” and “internal_passport” elements.
+	IdDocument *IdDocumentData
+
+	// Set for “address” elements.
+	ResidentialAddress *ResidentialAddress
+}
+
+// DecryptedPassportData is PassportData with its credentials and element data decrypted and verified.
+type DecryptedPassportData struct {
+	// Bot-specified nonce. Compare it with the nonce passed in the authorization request to prevent replay attacks.
+	Nonce string
+
+	// Decrypted elements in the order they were received.
+	Elements []DecryptedPassportElement
+}
+
+// PassportDecryptor decrypts Telegram Passport data with the bot's private RSA key.
+//
+// See "Telegram Passport" https://core.telegram.org/passport#decrypting-data
+type PassportDecryptor struct {
+	privateKey *rsa.PrivateKey
+}
+
+// NewPassportDecryptor creates a decryptor from a PEM-encoded RSA private key in PKCS #1 or PKCS #8 form.
+func NewPassportDecryptor(privateKeyPEM []byte) (*PassportDecryptor, error) {
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return nil, errors.New("error decoding private key: no PEM block found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return &PassportDecryptor{privateKey: key}, nil
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing private key: %w", err)
+	}
+
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is %T, not an RSA key", parsed)
+	}
+
+	return &PassportDecryptor{privateKey: key}, nil
+}
+
+// DecryptCredentials decrypts the credentials secret with the bot's private key, then decrypts and verifies the credentials.
+func (d *PassportDecryptor) DecryptCredentials(encrypted EncryptedCredentials) (Credentials, error) {
+	encryptedSecret, err := base64.StdEncoding.DecodeString(encrypted.Secret)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("error decoding credentials secret: %w", err)
+	}
+
+	secret, err := rsa.DecryptOAEP(sha1.New(), nil, d.privateKey, encryptedSecret, nil)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("error decrypting credentials secret: %w", err)
+	}
+
+	hash, err := base64.StdEncoding.DecodeString(encrypted.Hash)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("error decoding credentials hash: %w", err)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(encrypted.Data)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("error decoding credentials data: %w", err)
+	}
+
+	plaintext, err := decryptPassportValue(secret, hash, data)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("error decrypting credentials: %w", err)
+	}
+
+	var credentials Credentials
+	if err := json.Unmarshal(plaintext, &credentials); err != nil {
+		return Credentials{}, fmt.Errorf("error decoding credentials: %w", err)
+	}
+
+	return credentials, nil
+}
+
+// Decrypt decrypts and verifies the credentials and the data of every element of passportData.
+func (d *PassportDecryptor) Decrypt(passportData PassportData) (DecryptedPassportData, error) {
+	credentials, err := d.DecryptCredentials(passportData.Credentials)
+	if err != nil {
+		return DecryptedPassportData{}, err
+	}
+
+	decrypted := DecryptedPassportData{Nonce: credentials.Nonce}
+	for _, element := range passportData.Data {
+		result := DecryptedPassportElement{Element: element, Credentials: credentials.SecureData.Value(element.Type)}
+
+		switch element.Type {
+		case "personal_details":
+			result.PersonalDetails = new(PersonalDetails)
+			err = decryptPassportElementData(element, result.Credentials, result.PersonalDetails)
+		case "passport", "driver_license", "identity_card", "internal_passport":
+			result.IdDocument = new(IdDocumentData)
+			err = decryptPassportElementData(element, result.Credentials, result.IdDocument)
+		case "address":
+			result.ResidentialAddress = new(ResidentialAddress)
+			err = decryptPassportElementData(element, result.Credentials, result.ResidentialAddress)
+		}
+		if err != nil {
+			return DecryptedPassportData{}, fmt.Errorf("error decrypting %s element: %w", element.Type, err)
+		}
+
+		decrypted.Elements = append(decrypted.Elements, result)
+	}
+
+	return decrypted, nil
+}
+
+// DecryptFile decrypts and verifies the downloaded contents of a Telegram Passport file.
+func (d *PassportDecryptor) DecryptFile(encrypted []byte, credentials FileCredentials) ([]byte, error) {
+	secret, hash, err := decodePassportSecretAndHash(credentials.Secret, credentials.FileHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptPassportValue(secret, hash, encrypted)
+}
+
+// DownloadPassportFile downloads a Telegram Passport file and decrypts it with decryptor.
+func (b *Bot) DownloadPassportFile(file PassportFile, credentials FileCredentials, decryptor *PassportDecryptor) ([]byte, error) {
+	info, err := b.GetFile(GetFileRequest{FileID: file.FileID})
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := b.DownloadFile(info)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptor.DecryptFile(encrypted, credentials)
+}
+
+// decryptPassportElementData decrypts the data field of element with credentials and decodes it into target.
+func decryptPassportElementData(element EncryptedPassportElement, credentials *SecureValue, target any) error {
+	if element.Data == nil {
+		return errors.New("element has no data")
+	}
+	if credentials == nil || credentials.Data == nil {
+		return errors.New("credentials have no data secret for the element")
+	}
+
+	secret, hash, err := decodePassportSecretAndHash(credentials.Data.Secret, credentials.Data.DataHash)
+	if err != nil {
+		return err
+	}
+
+	data, err := base64.StdEncoding.DecodeString(*element.Data)
+	if err != nil {
+		return fmt.Errorf("error decoding element data: %w", err)
+	}
+
+	plaintext, err := decryptPassportValue(secret, hash, data)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(plaintext, target); err != nil {
+		return fmt.Errorf("error decoding element data: %w", err)
+	}
+	return nil
+}
+
+// decodePassportSecretAndHash decodes base64-encoded secret and hash values from the credentials.
+func decodePassportSecretAndHash(encodedSecret, encodedHash string) (secret, hash []byte, err error) {
+	if secret, err = base64.StdEncoding.DecodeString(encodedSecret); err != nil {
+		return nil, nil, fmt.Errorf("error decoding secret: %w", err)
+	}
+	if hash, err = base64.StdEncoding.DecodeString(encodedHash); err != nil {
+		return nil, nil, fmt.Errorf("error decoding hash: %w", err)
+	}
+	return secret, hash, nil
+}
+
+// decryptPassportValue decrypts data with AES-256-CBC, using a key and IV derived as SHA-512(secret + hash),
+// checks that SHA-256 of the padded plaintext equals hash, and strips the random padding whose length is stored in the first byte.
+func decryptPassportValue(secret, hash, data []byte) ([]byte, error) {
+	if len(data) == 0 || len(data)%aes.BlockSize != 0 {
+		return nil, ErrPassportDataInvalid
+	}
+
+	secretHash := sha512.Sum512(append(append([]byte{}, secret...), hash...))
+	block, err := aes.NewCipher(secretHash[:32])
+	if err != nil {
+		return nil, fmt.Errorf("error creating cipher: %w", err)
+	}
+
+	plaintext := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, secretHash[32:48]).CryptBlocks(plaintext, data)
+
+	dataHash := sha256.Sum256(plaintext)
+	if subtle.ConstantTimeCompare(dataHash[:], hash) != 1 {
+		return nil, ErrPassportDataInvalid
+	}
+
+	padding := int(plaintext[0])
+	if padding < 32 || padding > len(plaintext) {
+		return nil, ErrPassportDataInvalid
+	}
+
+	return plaintext[padding:], nil
+}
@@ -0,0 +1,11 @@
+package telegram
+
+// PassportElementError represents an error in the Telegram Passport element which was submitted that should be resolved by the user.
+// It is implemented by PassportElementErrorDataField, PassportElementErrorFrontSide, PassportElementErrorReverseSide,
+// PassportElementErrorSelfie, PassportElementErrorFile, PassportElementErrorFiles, PassportElementErrorTranslationFile,
+// PassportElementErrorTranslationFiles and PassportElementErrorUnspecified.
+//
+// See "PassportElementError" https://core.telegram.org/bots/api#passportelementerror
+type PassportElementError interface {
+	passportElementError()
+}
@@ -0,0 +1,23 @@
+package telegram
+
+// PassportElementErrorDataField represents an issue in one of the data fields that was provided by the user. The error is considered resolved when the field's value changes.
+//
+// See "PassportElementErrorDataField" https://core.telegram.org/bots/api#passportelementerrordatafield
+type PassportElementErrorDataField struct {
+	// (Required) Error source, must be “data”.
+	Source string `json:"source"`
+
+	// (Required) The section of the user's Telegram Passport which has the error, one of “personal_details”, “passport”, “driver_license”, “identity_card”, “internal_passport”, “address”.
+	Type string `json:"type"`
+
+	// (Required) Name of the data field which has the error.
+	FieldName string `json:"field_name"`
+
+	// (Required) Base64-encoded data hash.
+	DataHash string `json:"data_hash"`
+
+	// (Required) Error message.
+	Message string `json:"message"`
+}
+
+func (PassportElementErrorDataField) passportElementError() {}
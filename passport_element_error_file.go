@@ -0,0 +1,20 @@
+package telegram
+
+// PassportElementErrorFile represents an issue with a document scan. The error is considered resolved when the file with the document scan changes.
+//
+// See "PassportElementErrorFile" https://core.telegram.org/bots/api#passportelementerrorfile
+type PassportElementErrorFile struct {
+	// (Required) Error source, must be “file”.
+	Source string `json:"source"`
+
+	// (Required) The section of the user's Telegram Passport which has the issue, one of “utility_bill”, “bank_statement”, “rental_agreement”, “passport_registration”, “temporary_registration”.
+	Type string `json:"type"`
+
+	// (Required) Base64-encoded file hash.
+	FileHash string `json:"file_hash"`
+
+	// (Required) Error message.
+	Message string `json:"message"`
+}
+
+func (PassportElementErrorFile) passportElementError() {}
@@ -0,0 +1,20 @@
+package telegram
+
+// PassportElementErrorFiles represents an issue with a list of scans. The error is considered resolved when the list of files containing the scans changes.
+//
+// See "PassportElementErrorFiles" https://core.telegram.org/bots/api#passportelementerrorfiles
+type PassportElementErrorFiles struct {
+	// (Required) Error source, must be “files”.
+	Source string `json:"source"`
+
+	// (Required) The section of the user's Telegram Passport which has the issue, one of “utility_bill”, “bank_statement”, “rental_agreement”, “passport_registration”, “temporary_registration”.
+	Type string `json:"type"`
+
+	// (Required) List of base64-encoded file hashes.
+	FileHashes []string `json:"file_hashes"`
+
+	// (Required) Error message.
+	Message string `json:"message"`
+}
+
+func (PassportElementErrorFiles) passportElementError() {}
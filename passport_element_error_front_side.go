@@ -0,0 +1,20 @@
+package telegram
+
+// PassportElementErrorFrontSide represents an issue with the front side of a document. The error is considered resolved when the file with the front side of the document changes.
+//
+// See "PassportElementErrorFrontSide" https://core.telegram.org/bots/api#passportelementerrorfrontside
+type PassportElementErrorFrontSide struct {
+	// (Required) Error source, must be “front_side”.
+	Source string `json:"source"`
+
+	// (Required) The section of the user's Telegram Passport which has the issue, one of “passport”, “driver_license”, “identity_card”, “internal_passport”.
+	Type string `json:"type"`
+
+	// (Required) Base64-encoded hash of the file with the front side of the document.
+	FileHash string `json:"file_hash"`
+
+	// (Required) Error message.
+	Message string `json:"message"`
+}
+
+func (PassportElementErrorFrontSide) passportElementError() {}
@@ -0,0 +1,20 @@
+package telegram
+
+// PassportElementErrorSelfie represents an issue with the selfie with a document. The error is considered resolved when the file with the selfie changes.
+//
+// See "PassportElementErrorSelfie" https://core.telegram.org/bots/api#passportelementerrorselfie
+type PassportElementErrorSelfie struct {
+	// (Required) Error source, must be “selfie”.
+	Source string `json:"source"`
+
+	// (Required) The section of the user's Telegram Passport which has the issue, one of “passport”, “driver_license”, “identity_card”, “internal_passport”.
+	Type string `json:"type"`
+
+	// (Required) Base64-encoded hash of the file with the selfie.
+	FileHash string `json:"file_hash"`
+
+	// (Required) Error message.
+	Message string `json:"message"`
+}
+
+func (PassportElementErrorSelfie) passportElementError() {}
@@ -0,0 +1,20 @@
+package telegram
+
+// PassportElementErrorTranslationFile represents an issue with one of the files that constitute the translation of a document. The error is considered resolved when the file changes.
+//
+// See "PassportElementErrorTranslationFile" https://core.telegram.org/bots/api#passportelementerrortranslationfile
+type PassportElementErrorTranslationFile struct {
+	// (Required) Error source, must be “translation_file”.
+	Source string `json:"source"`
+
+	// (Required) Type of element of the user's Telegram Passport which has the issue, one of “passport”, “driver_license”, “identity_card”, “internal_passport”, “utility_bill”, “bank_statement”, “rental_agreement”, “passport_registration”, “temporary_registration”.
+	Type string `json:"type"`
+
+	// (Required) Base64-encoded file hash.
+	FileHash string `json:"file_hash"`
+
+	// (Required) Error message.
+	Message string `json:"message"`
+}
+
+func (PassportElementErrorTranslationFile) passportElementError() {}
@@ -0,0 +1,20 @@
+package telegram
+
+// PassportElementErrorTranslationFiles represents an issue with the translated version of a document. The error is considered resolved when a file with the document translation change.
+//
+// See "PassportElementErrorTranslationFiles" https://core.telegram.org/bots/api#passportelementerrortranslationfiles
+type PassportElementErrorTranslationFiles struct {
+	// (Required) Error source, must be “translation_files”.
+	Source string `json:"source"`
+
+	// (Required) Type of element of the user's Telegram Passport which has the issue, one of “passport”, “driver_license”, “identity_card”, “internal_passport”, “utility_bill”, “bank_statement”, “rental_agreement”, “passport_registration”, “temporary_registration”.
+	Type string `json:"type"`
+
+	// (Required) List of base64-encoded file hashes.
+	FileHashes []string `json:"file_hashes"`
+
+	// (Required) Error message.
+	Message string `json:"message"`
+}
+
+func (PassportElementErrorTranslationFiles) passportElementError() {}
@@ -0,0 +1,36 @@
+package telegram
+
+// PersonalDetails represents personal details decrypted from a “personal_details” Telegram Passport element.
+//
+// See "PersonalDetails" https://core.telegram.org/passport#personaldetails
+type PersonalDetails struct {
+	// (Required) First Name.
+	FirstName string `json:"first_name"`
+
+	// (Required) Last Name.
+	LastName string `json:"last_name"`
+
+	// (Optional) Middle Name.
+	MiddleName *string `json:"middle_name,omitempty"`
+
+	// (Required) Date of birth in DD.MM.YYYY format.
+	BirthDate string `json:"birth_date"`
+
+	// (Required) Gender, male or female.
+	Gender string `json:"gender"`
+
+	// (Required) Citizenship (ISO 3166-1 alpha-2 country code).
+	CountryCode string `json:"country_code"`
+
+	// (Required) Country of residence (ISO 3166-1 alpha-2 country code).
+	ResidenceCountryCode string `json:"residence_country_code"`
+
+	// (Required) First Name in the language of the user's country of residence.
+	FirstNameNative string `json:"first_name_native"`
+
+	// (Required) Last Name in the language of the user's country of residence.
+	LastNameNative string `json:"last_name_native"`
+
+	// (Optional) Middle Name in the language of the user's country of residence.
+	MiddleNameNative *string `json:"middle_name_native,omitempty"`
+}
@@ -0,0 +1,159 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+)
+
+// DefaultPollTimeout is the long polling timeout a Poller uses when Timeout is not set.
+const DefaultPollTimeout = 30 * time.Second
+
+// maxPollRetryDelay caps the delay between retries after getUpdates fails.
+const maxPollRetryDelay = 30 * time.Second
+
+// Poller receives updates with long polling and passes them to a handler one at a time, in order.
+//
+// Polling doesn't work while a webhook is set; remove it with DeleteWebhook first.
+type Poller struct {
+	// (Required) Bot to receive updates for.
+	Bot *Bot
+
+	// (Required) Handler called for each update, typically a Router's HandleUpdate.
+	Handler HandlerFunc
+
+	// (Optional) Update types to receive. NewPoller sets it to the update types the router handles. If nil, the update types
+	// set by the previous getUpdates or setWebhook call are kept.
+	AllowedUpdates []UpdateType
+
+	// (Optional) Long polling timeout. Defaults to DefaultPollTimeout.
+	Timeout time.Duration
+
+	// (Optional) Maximum number of updates to receive per request, 1-100. Defaults to 100.
+	Limit int
+
+	// (Optional) Called with errors returned by the handler and by getUpdates. Defaults to logging them.
+	OnError func(err error)
+}
+
+// NewPoller creates a poller that passes updates to router and receives exactly the update types the router handles.
+func NewPoller(bot *Bot, router *Router) *Poller {
+	return &Poller{
+		Bot:            bot,
+		Handler:        router.HandleUpdate,
+		AllowedUpdates: router.UpdateTypes(),
+	}
+}
+
+// PollerFor creates a poller like NewPoller that receives allowedUpdates instead, logging a warning if it misses update
+// types the router handles. A nil allowedUpdates keeps the update types the router handles.
+func PollerFor(bot *Bot, router *Router, allowedUpdates []UpdateType) *Poller {
+	poller := NewPoller(bot, router)
+	if allowedUpdates != nil {
+		warnMissingUpdateTypes(router.MissingUpdateTypes(allowedUpdates))
+		poller.AllowedUpdates = allowedUpdates
+	}
+	return poller
+}
+
+// Run polls for updates until ctx is canceled, which is reported as the returned error. Failed getUpdates calls
+// are retried with increasing delays. Updates that can't be decoded are reported as an *UpdateDecodeError and skipped.
+func (p *Poller) Run(ctx context.Context) error {
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = DefaultPollTimeout
+	}
+	seconds := int(timeout / time.Second)
+
+	request := GetUpdatesRequest{Timeout: &seconds, AllowedUpdates: p.AllowedUpdates}
+	if p.Limit > 0 {
+		request.Limit = &p.Limit
+	}
+
+	delay := time.Duration(0)
+	for {
+		rawUpdates, err := p.Bot.getRawUpdates(ctx, request)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			p.report(err)
+			delay = min(max(2*delay, time.Second), maxPollRetryDelay)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+			continue
+		}
+		delay = 0
+
+		for _, rawUpdate := range rawUpdates {
+			update, err := decodeUpdate(rawUpdate)
+			if update.UpdateID >= 0 {
+				offset := update.UpdateID + 1
+				request.Offset = &offset
+			}
+			if err != nil {
+				p.report(err)
+				continue
+			}
+			if err := p.Handler(p.Bot, update); err != nil {
+				p.report(err)
+			}
+		}
+
+		// allowed_updates is remembered by Telegram, so it only has to be sent once.
+		request.AllowedUpdates = nil
+	}
+}
+
+// UpdateDecodeError is reported by a Poller for a received update that can't be decoded. The poller skips such updates,
+// so that a single one doesn't stop polling.
+type UpdateDecodeError struct {
+	// Identifier of the update.
+	UpdateID int
+
+	// The update as received.
+	Raw json.RawMessage
+
+	// The decoding error.
+	Err error
+}
+
+func (e *UpdateDecodeError) Error() string {
+	return fmt.Sprintf("error decoding update %d: %v", e.UpdateID, e.Err)
+}
+
+func (e *UpdateDecodeError) Unwrap() error {
+	return e.Err
+}
+
+// decodeUpdate decodes a received update. If it can't be decoded, the returned update only carries the update's identifier,
+// or -1 if even that can't be read, so that it can still be skipped.
+func decodeUpdate(rawUpdate json.RawMessage) (Update, error) {
+	var update Update
+	err := json.Unmarshal(rawUpdate, &update)
+	if err == nil {
+		return update, nil
+	}
+
+	var probe struct {
+		UpdateID *int `json:"update_id"`
+	}
+	if json.Unmarshal(rawUpdate, &probe) != nil || probe.UpdateID == nil {
+		return Update{UpdateID: -1}, &UpdateDecodeError{UpdateID: -1, Raw: rawUpdate, Err: err}
+	}
+	return Update{UpdateID: *probe.UpdateID}, &UpdateDecodeError{UpdateID: *probe.UpdateID, Raw: rawUpdate, Err: err}
+}
+
+// report passes err to OnError, or logs it.
+func (p *Poller) report(err error) {
+	if p.OnError != nil {
+		p.OnError(err)
+		return
+	}
+	log.Printf("telegram: %v", err)
+}
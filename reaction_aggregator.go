@@ -0,0 +1,169 @@
+package telegram
+
+import (
+	"sort"
+	"sync"
+)
+
+// MessageReactions holds the reaction counts of a single message.
+type MessageReactions struct {
+	// Identifier of the chat containing the message.
+	ChatID int64
+
+	// Identifier of the message inside the chat.
+	MessageID int
+
+	// Total number of reactions on the message.
+	Total int
+
+	// Reactions present on the message, most frequent first.
+	Reactions []ReactionCount
+}
+
+// reactionMessageKey identifies a message in a chat.
+type reactionMessageKey struct {
+	chatID    int64
+	messageID int
+}
+
+// reactionTally counts the reactions of a single message by reaction key.
+type reactionTally struct {
+	types  map[string]ReactionType
+	counts map[string]int
+}
+
+// ReactionAggregator keeps per-message reaction counts from message_reaction and message_reaction_count updates.
+//
+// message_reaction_count updates, which Telegram sends for chats with anonymous reactions such as channels, replace the counts
+// of the message. message_reaction updates from individual users adjust them by the difference between the old and new reactions.
+// Both update types must be listed in allowed_updates, and the bot must be an administrator in the chat to receive them.
+type ReactionAggregator struct {
+	mu       sync.Mutex
+	messages map[reactionMessageKey]*reactionTally
+}
+
+// NewReactionAggregator creates an empty aggregator.
+func NewReactionAggregator() *ReactionAggregator {
+	return &ReactionAggregator{messages: make(map[reactionMessageKey]*reactionTally)}
+}
+
+// Middleware returns a middleware that records reaction updates before passing them on.
+func (a *ReactionAggregator) Middleware() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(bot *Bot, update Update) error {
+			a.RecordUpdate(update)
+			return next(bot, update)
+		}
+	}
+}
+
+// RecordUpdate records the reaction change carried by the update, if any.
+func (a *ReactionAggregator) RecordUpdate(update Update) {
+	switch {
+	case update.MessageReaction != nil:
+		a.RecordReaction(*update.MessageReaction)
+	case update.MessageReactionCount != nil:
+		a.RecordReactionCount(*update.MessageReactionCount)
+	}
+}
+
+// RecordReaction applies a user's reaction change to the counts of the message.
+func (a *ReactionAggregator) RecordReaction(reaction MessageReactionUpdated) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	tally := a.tally(reactionMessageKey{reaction.Chat.ID, reaction.MessageID})
+	for _, old := range reaction.OldReaction {
+		key := old.Key()
+		if tally.counts[key] > 0 {
+			tally.counts[key]--
+		}
+		if tally.counts[key] == 0 {
+			delete(tally.counts, key)
+			delete(tally.types, key)
+		}
+	}
+	for _, added := range reaction.NewReaction {
+		key := added.Key()
+		tally.types[key] = added
+		tally.counts[key]++
+	}
+}
+
+// RecordReactionCount replaces the counts of the message with the reactions in the update.
+func (a *ReactionAggregator) RecordReactionCount(count MessageReactionCountUpdated) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	tally := &reactionTally{types: make(map[string]ReactionType), counts: make(map[string]int)}
+	for _, reaction := range count.Reactions {
+		key := reaction.Type.Key()
+		tally.types[key] = reaction.Type
+		tally.counts[key] = reaction.TotalCount
+	}
+	a.messages[reactionMessageKey{count.Chat.ID, count.MessageID}] = tally
+}
+
+// Message returns the reaction counts of a message.
+func (a *ReactionAggregator) Message(chatID int64, messageID int) MessageReactions {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	key := reactionMessageKey{chatID, messageID}
+	return a.messages[key].reactions(key)
+}
+
+// Top returns up to n messages of the chat with the most reactions, most reacted first. Ties are broken by the newer
+// message coming first. A non-positive n returns all messages.
+func (a *ReactionAggregator) Top(chatID int64, n int) []MessageReactions {
+	a.mu.Lock()
+	var top []MessageReactions
+	for key, tally := range a.messages {
+		if key.chatID == chatID {
+			top = append(top, tally.reactions(key))
+		}
+	}
+	a.mu.Unlock()
+
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Total != top[j].Total {
+			return top[i].Total > top[j].Total
+		}
+		return top[i].MessageID > top[j].MessageID
+	})
+
+	if n > 0 && len(top) > n {
+		top = top[:n]
+	}
+	return top
+}
+
+// tally returns the tally of the message, creating it if needed.
+func (a *ReactionAggregator) tally(key reactionMessageKey) *reactionTally {
+	tally, ok := a.messages[key]
+	if !ok {
+		tally = &reactionTally{types: make(map[string]ReactionType), counts: make(map[string]int)}
+		a.messages[key] = tally
+	}
+	return tally
+}
+
+// reactions returns the counts of the tally, most frequent first. A nil tally has no reactions.
+func (t *reactionTally) reactions(key reactionMessageKey) MessageReactions {
+	result := MessageReactions{ChatID: key.chatID, MessageID: key.messageID}
+	if t == nil {
+		return result
+	}
+
+	for reactionKey, count := range t.counts {
+		result.Total += count
+		result.Reactions = append(result.Reactions, ReactionCount{Type: t.types[reactionKey], TotalCount: count})
+	}
+	sort.Slice(result.Reactions, func(i, j int) bool {
+		if result.Reactions[i].TotalCount != result.Reactions[j].TotalCount {
+			return result.Reactions[i].TotalCount > result.Reactions[j].TotalCount
+		}
+		return result.Reactions[i].Type.Key() < result.Reactions[j].Type.Key()
+	})
+	return result
+}
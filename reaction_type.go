@@ -0,0 +1,86 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ReactionType describes the type of a reaction. It can be one of ReactionTypeEmoji, ReactionTypeCustomEmoji
+// or ReactionTypePaid; exactly one of the fields is set after decoding. Reactions of unknown types are decoded into Unknown.
+//
+// See "ReactionType" https://core.telegram.org/bots/api#reactiontype
+type ReactionType struct {
+	Emoji       *ReactionTypeEmoji
+	CustomEmoji *ReactionTypeCustomEmoji
+	Paid        *ReactionTypePaid
+	Unknown     *ReactionTypeUnknown
+}
+
+// UnmarshalJSON decodes the variant named by the type field.
+func (r *ReactionType) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return fmt.Errorf("error decoding reaction type: %w", err)
+	}
+
+	*r = ReactionType{}
+	switch probe.Type {
+	case "emoji":
+		r.Emoji = new(ReactionTypeEmoji)
+		return json.Unmarshal(data, r.Emoji)
+	case "custom_emoji":
+		r.CustomEmoji = new(ReactionTypeCustomEmoji)
+		return json.Unmarshal(data, r.CustomEmoji)
+	case "paid":
+		r.Paid = new(ReactionTypePaid)
+		return json.Unmarshal(data, r.Paid)
+	default:
+		r.Unknown = &ReactionTypeUnknown{Type: probe.Type, Raw: rawVariant(data)}
+		return nil
+	}
+}
+
+// MarshalJSON encodes whichever variant is set.
+func (r ReactionType) MarshalJSON() ([]byte, error) {
+	switch {
+	case r.Emoji != nil:
+		return json.Marshal(r.Emoji)
+	case r.CustomEmoji != nil:
+		return json.Marshal(r.CustomEmoji)
+	case r.Paid != nil:
+		return json.Marshal(r.Paid)
+	case r.Unknown != nil:
+		return json.Marshal(r.Unknown)
+	default:
+		return nil, fmt.Errorf("reaction type has no variant set")
+	}
+}
+
+// Key returns a string that identifies the reaction, e.g. "emoji:👍", "custom_emoji:<id>" or "paid". Reactions of unknown
+// types are identified by their type and JSON encoding. Equal reactions have equal keys.
+func (r ReactionType) Key() string {
+	switch {
+	case r.Emoji != nil:
+		return "emoji:" + r.Emoji.Emoji
+	case r.CustomEmoji != nil:
+		return "custom_emoji:" + r.CustomEmoji.CustomEmojiID
+	case r.Paid != nil:
+		return "paid"
+	case r.Unknown != nil:
+		return r.Unknown.Type + ":" + string(r.Unknown.Raw)
+	default:
+		return ""
+	}
+}
+
+// NewReactionEmoji returns a reaction with a regular emoji.
+func NewReactionEmoji(emoji string) ReactionType {
+	return ReactionType{Emoji: &ReactionTypeEmoji{Type: "emoji", Emoji: emoji}}
+}
+
+// NewReactionCustomEmoji returns a reaction with a custom emoji.
+func NewReactionCustomEmoji(customEmojiID string) ReactionType {
+	return ReactionType{CustomEmoji: &ReactionTypeCustomEmoji{Type: "custom_emoji", CustomEmojiID: customEmojiID}}
+}
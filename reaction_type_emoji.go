@@ -0,0 +1,15 @@
+package telegram
+
+// ReactionTypeEmoji represents a reaction that is based on an emoji.
+//
+// See "ReactionTypeEmoji" https://core.telegram.org/bots/api#reactiontypeemoji
+type ReactionTypeEmoji struct {
+	// (Required) Type of the reaction, always “emoji”.
+	Type string `json:"type"`
+
+	// (Required) Reaction emoji. Currently, it can be one of "👍", "👎", "❤", "🔥", "🥰", "👏", "😁", "🤔", "🤯", "😱", "🤬", "😢",
+	// "🎉", "🤩", "🤮", "💩", "🙏", "👌", "🕊", "🤡", "🥱", "🥴", "😍", "🐳", "❤‍🔥", "🌚", "🌭", "💯", "🤣", "⚡", "🍌", "🏆", "💔",
+	// "🤨", "😐", "🍓", "🍾", "💋", "🖕", "😈", "😴", "😭", "🤓", "👻", "👨‍💻", "👀", "🎃", "🙈", "😇", "😨", "🤝", "✍", "🤗", "🫡",
+	// "🎅", "🎄", "☃", "💅", "🤪", "🗿", "🆒", "💘", "🙉", "🦄", "😘", "💊", "🙊", "😎", "👾", "🤷‍♂", "🤷", "🤷‍♀", "😡".
+	Emoji string `json:"emoji"`
+}
@@ -0,0 +1,9 @@
+package telegram
+
+// ReactionTypePaid represents a paid reaction.
+//
+// See "ReactionTypePaid" https://core.telegram.org/bots/api#reactiontypepaid
+type ReactionTypePaid struct {
+	// (Required) Type of the reaction, always “paid”.
+	Type string `json:"type"`
+}
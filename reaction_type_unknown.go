@@ -0,0 +1,19 @@
+package telegram
+
+import "encoding/json"
+
+// ReactionTypeUnknown represents a reaction of a type that isn't described by this package.
+type ReactionTypeUnknown struct {
+	// (Required) Type of the reaction.
+	Type string `json:"type"`
+
+	// (Required) The reaction object as received.
+	Raw json.RawMessage `json:"-"`
+}
+
+// MarshalJSON encodes Raw, or only the type if Raw is empty.
+func (r ReactionTypeUnknown) MarshalJSON() ([]byte, error) {
+	return marshalRawVariant(r.Raw, struct {
+		Type string `json:"type"`
+	}{r.Type})
+}
@@ -0,0 +1,63 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ReplaceStickerInSetRequest represents a request to replace an existing sticker in a sticker set with a new one.
+//
+// See "replaceStickerInSet" https://core.telegram.org/bots/api#replacestickerinset
+type ReplaceStickerInSetRequest struct {
+	// (Required) User identifier of the sticker set owner.
+	UserID int64 `json:"user_id"`
+
+	// (Required) Sticker set name.
+	Name string `json:"name"`
+
+	// (Required) File identifier of the replaced sticker.
+	OldSticker string `json:"old_sticker"`
+
+	// (Required) A JSON-serialized object with information about the added sticker. If exactly the same sticker had already been added
+	// to the set, then the set remains unchanged.
+	Sticker InputSticker `json:"sticker"`
+}
+
+// ReplaceStickerInSet replaces an existing sticker in a sticker set with a new one. The method is equivalent to calling
+// deleteStickerFromSet, then addStickerToSet, then setStickerPositionInSet.
+//
+// See "replaceStickerInSet" https://core.telegram.org/bots/api#replacestickerinset
+func (b *Bot) ReplaceStickerInSet(request ReplaceStickerInSetRequest) error {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "replaceStickerInSet", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package telegram
+
+// ReplyKeyboardMarkup represents a custom keyboard with reply options (see Introduction to bots for details and examples).
+// Not supported in channels and for messages sent on behalf of a Telegram Business account.
+//
+// See "ReplyKeyboardMarkup" https://core.telegram.org/bots/api#replykeyboardmarkup
+type ReplyKeyboardMarkup struct {
+	// (Required) Array of button rows, each represented by an Array of KeyboardButton objects.
+	Keyboard [][]KeyboardButton `json:"keyboard"`
+
+	// (Optional) Requests clients to always show the keyboard when the regular keyboard is hidden. Defaults to false, in which case
+	// the custom keyboard can be hidden and opened with a keyboard icon.
+	IsPersistent *bool `json:"is_persistent,omitempty"`
+
+	// (Optional) Requests clients to resize the keyboard vertically for optimal fit (e.g., make the keyboard smaller if there are just
+	// two rows of buttons). Defaults to false, in which case the custom keyboard is always of the same height as the app's standard keyboard.
+	ResizeKeyboard *bool `json:"resize_keyboard,omitempty"`
+
+	// (Optional) Requests clients to hide the keyboard as soon as it's been used. The keyboard will still be available, but clients will
+	// automatically display the usual letter-keyboard in the chat - the user can press a special button in the input field to see the
+	// custom keyboard again. Defaults to false.
+	OneTimeKeyboard *bool `json:"one_time_keyboard,omitempty"`
+
+	// (Optional) The placeholder to be shown in the input field when the keyboard is active; 1-64 characters.
+	InputFieldPlaceholder *string `json:"input_field_placeholder,omitempty"`
+
+	// (Optional) Use this parameter if you want to show the keyboard to specific users only. Targets: 1) users that are @mentioned in the
+	// text of the Message object; 2) if the bot's message is a reply to a message in the same chat and forum topic, sender of the original message.
+	Selective *bool `json:"selective,omitempty"`
+}
@@ -0,0 +1,17 @@
+package telegram
+
+// ReplyKeyboardRemove makes Telegram clients remove the current custom keyboard and display the default letter-keyboard.
+// By default, custom keyboards are displayed until a new keyboard is sent by a bot. An exception is made for one-time keyboards
+// that are hidden immediately after the user presses a button (see ReplyKeyboardMarkup).
+// Not supported in channels and for messages sent on behalf of a Telegram Business account.
+//
+// See "ReplyKeyboardRemove" https://core.telegram.org/bots/api#replykeyboardremove
+type ReplyKeyboardRemove struct {
+	// (Required) Requests clients to remove the custom keyboard (user will not be able to summon this keyboard; if you want to hide
+	// the keyboard from sight but keep it accessible, use one_time_keyboard in ReplyKeyboardMarkup). Must be True.
+	RemoveKeyboard bool `json:"remove_keyboard"`
+
+	// (Optional) Use this parameter if you want to remove the keyboard for specific users only. Targets: 1) users that are @mentioned
+	// in the text of the Message object; 2) if the bot's message is a reply to a message in the same chat and forum topic, sender of the original message.
+	Selective *bool `json:"selective,omitempty"`
+}
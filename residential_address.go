@@ -0,0 +1,24 @@
+package telegram
+
+// ResidentialAddress represents a residential address decrypted from an “address” Telegram Passport element.
+//
+// See "ResidentialAddress" https://core.telegram.org/passport#residentialaddress
+type ResidentialAddress struct {
+	// (Required) First line for the address.
+	StreetLine1 string `json:"street_line1"`
+
+	// (Optional) Second line for the address.
+	StreetLine2 *string `json:"street_line2,omitempty"`
+
+	// (Required) City.
+	City string `json:"city"`
+
+	// (Optional) State.
+	State *string `json:"state,omitempty"`
+
+	// (Required) ISO 3166-1 alpha-2 country code.
+	CountryCode string `json:"country_code"`
+
+	// (Required) Address post code.
+	PostCode string `json:"post_code"`
+}
@@ -0,0 +1,60 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// RevenueWithdrawalState describes the state of a revenue withdrawal operation. It can be one of
+// RevenueWithdrawalStatePending, RevenueWithdrawalStateSucceeded or RevenueWithdrawalStateFailed;
+// exactly one of the fields is set after decoding. States of unknown types are decoded into Unknown.
+//
+// See "RevenueWithdrawalState" https://core.telegram.org/bots/api#revenuewithdrawalstate
+type RevenueWithdrawalState struct {
+	Pending   *RevenueWithdrawalStatePending
+	Succeeded *RevenueWithdrawalStateSucceeded
+	Failed    *RevenueWithdrawalStateFailed
+	Unknown   *RevenueWithdrawalStateUnknown
+}
+
+// UnmarshalJSON decodes the variant named by the type field.
+func (s *RevenueWithdrawalState) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return fmt.Errorf("error decoding revenue withdrawal state: %w", err)
+	}
+
+	*s = RevenueWithdrawalState{}
+	switch probe.Type {
+	case "pending":
+		s.Pending = new(RevenueWithdrawalStatePending)
+		return json.Unmarshal(data, s.Pending)
+	case "succeeded":
+		s.Succeeded = new(RevenueWithdrawalStateSucceeded)
+		return json.Unmarshal(data, s.Succeeded)
+	case "failed":
+		s.Failed = new(RevenueWithdrawalStateFailed)
+		return json.Unmarshal(data, s.Failed)
+	default:
+		s.Unknown = &RevenueWithdrawalStateUnknown{Type: probe.Type, Raw: rawVariant(data)}
+		return nil
+	}
+}
+
+// MarshalJSON encodes whichever variant is set.
+func (s RevenueWithdrawalState) MarshalJSON() ([]byte, error) {
+	switch {
+	case s.Pending != nil:
+		return json.Marshal(s.Pending)
+	case s.Succeeded != nil:
+		return json.Marshal(s.Succeeded)
+	case s.Failed != nil:
+		return json.Marshal(s.Failed)
+	case s.Unknown != nil:
+		return json.Marshal(s.Unknown)
+	default:
+		return nil, fmt.Errorf("revenue withdrawal state has no variant set")
+	}
+}
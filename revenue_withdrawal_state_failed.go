@@ -0,0 +1,9 @@
+package telegram
+
+// RevenueWithdrawalStateFailed describes a withdrawal that failed and was refunded.
+//
+// See "RevenueWithdrawalStateFailed" https://core.telegram.org/bots/api#revenuewithdrawalstatefailed
+type RevenueWithdrawalStateFailed struct {
+	// (Required) Type of the state, always “failed”.
+	Type string `json:"type"`
+}
@@ -0,0 +1,9 @@
+package telegram
+
+// RevenueWithdrawalStatePending describes the state of a withdrawal that is in progress.
+//
+// See "RevenueWithdrawalStatePending" https://core.telegram.org/bots/api#revenuewithdrawalstatepending
+type RevenueWithdrawalStatePending struct {
+	// (Required) Type of the state, always “pending”.
+	Type string `json:"type"`
+}
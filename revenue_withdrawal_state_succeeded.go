@@ -0,0 +1,15 @@
+package telegram
+
+// RevenueWithdrawalStateSucceeded describes a withdrawal that succeeded.
+//
+// See "RevenueWithdrawalStateSucceeded" https://core.telegram.org/bots/api#revenuewithdrawalstatesucceeded
+type RevenueWithdrawalStateSucceeded struct {
+	// (Required) Type of the state, always “succeeded”.
+	Type string `json:"type"`
+
+	// (Required) Date the withdrawal was completed in Unix time.
+	Date int `json:"date"`
+
+	// (Required) An HTTPS URL that can be used to see transaction details.
+	URL string `json:"url"`
+}
@@ -0,0 +1,19 @@
+package telegram
+
+import "encoding/json"
+
+// RevenueWithdrawalStateUnknown represents a revenue withdrawal state of a type that isn't described by this package.
+type RevenueWithdrawalStateUnknown struct {
+	// (Required) Type of the state.
+	Type string `json:"type"`
+
+	// (Required) The state object as received.
+	Raw json.RawMessage `json:"-"`
+}
+
+// MarshalJSON encodes Raw, or only the type if Raw is empty.
+func (s RevenueWithdrawalStateUnknown) MarshalJSON() ([]byte, error) {
+	return marshalRawVariant(s.Raw, struct {
+		Type string `json:"type"`
+	}{s.Type})
+}
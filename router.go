@@ -0,0 +1,233 @@
+package telegram
+
+import (
+	"log"
+	"strings"
+)
+
+// Router dispatches incoming updates to the first registered handler whose route matches.
+//
+// Routes are tried in the order they were registered. Middlewares registered with Use wrap the whole dispatch,
+// so they also run for updates that no route matches.
+type Router struct {
+	routes      []route
+	middlewares []Middleware
+	subscribed  []UpdateType
+
+	// NotFound, if set, handles updates that no route matches. Unmatched updates are ignored otherwise.
+	NotFound HandlerFunc
+}
+
+// route pairs a handler with the update type it handles and an optional extra condition.
+type route struct {
+	updateType UpdateType
+	match      func(update Update) bool
+	handler    HandlerFunc
+
+	// handlerMaybe, if set, replaces handler and reports whether it handled the update. Updates it doesn't handle
+	// are passed on to the following routes.
+	handlerMaybe func(bot *Bot, update Update) (bool, error)
+}
+
+// NewRouter creates an empty router.
+func NewRouter() *Router {
+	return &Router{}
+}
+
+// Use appends middlewares that wrap every update handled by the router.
+func (r *Router) Use(middlewares ...Middleware) {
+	r.middlewares = append(r.middlewares, middlewares...)
+}
+
+// Handle registers handler for updates of the given type, e.g. UpdateTypeMessage or UpdateTypeCallbackQuery.
+// If match is not nil, the handler is only used for updates for which match returns true.
+func (r *Router) Handle(updateType UpdateType, match func(update Update) bool, handler HandlerFunc) {
+	r.routes = append(r.routes, route{updateType: updateType, match: match, handler: handler})
+}
+
+// handleMaybe registers a handler that may decline an update, which is then passed on to the following routes.
+func (r *Router) handleMaybe(updateType UpdateType, match func(update Update) bool, handler func(bot *Bot, update Update) (bool, error)) {
+	r.routes = append(r.routes, route{updateType: updateType, match: match, handlerMaybe: handler})
+}
+
+// OnMessage registers handler for new incoming messages.
+func (r *Router) OnMessage(handler HandlerFunc) {
+	r.Handle(UpdateTypeMessage, nil, handler)
+}
+
+// OnCallbackQuery registers handler for callback queries whose data starts with prefix.
+// An empty prefix matches every callback query, including game callback queries without data.
+func (r *Router) OnCallbackQuery(prefix string, handler HandlerFunc) {
+	if prefix == "" {
+		r.Handle(UpdateTypeCallbackQuery, nil, handler)
+		return
+	}
+
+	r.Handle(UpdateTypeCallbackQuery, func(update Update) bool {
+		data := update.CallbackQuery.Data
+		return data != nil && strings.HasPrefix(*data, prefix)
+	}, handler)
+}
+
+// OnBusinessConnection registers handler for updates about the bot being connected to or disconnected from a business account,
+// or about the connection being edited.
+func (r *Router) OnBusinessConnection(handler HandlerFunc) {
+	r.Handle(UpdateTypeBusinessConnection, nil, handler)
+}
+
+// OnBusinessMessage registers handler for new messages from connected business accounts with the given business connection.
+// An empty connectionID matches messages from every business connection.
+func (r *Router) OnBusinessMessage(connectionID string, handler HandlerFunc) {
+	r.Handle(UpdateTypeBusinessMessage, matchBusinessConnection(connectionID, func(update Update) *string {
+		return update.BusinessMessage.BusinessConnectionID
+	}), handler)
+}
+
+// OnEditedBusinessMessage registers handler for edited messages from connected business accounts with the given business connection.
+// An empty connectionID matches messages from every business connection.
+func (r *Router) OnEditedBusinessMessage(connectionID string, handler HandlerFunc) {
+	r.Handle(UpdateTypeEditedBusinessMessage, matchBusinessConnection(connectionID, func(update Update) *string {
+		return update.EditedBusinessMessage.BusinessConnectionID
+	}), handler)
+}
+
+// OnDeletedBusinessMessages registers handler for messages deleted from connected business accounts with the given business connection.
+// An empty connectionID matches deletions from every business connection.
+func (r *Router) OnDeletedBusinessMessages(connectionID string, handler HandlerFunc) {
+	r.Handle(UpdateTypeDeletedBusinessMessages, matchBusinessConnection(connectionID, func(update Update) *string {
+		return &update.DeletedBusinessMessages.BusinessConnectionID
+	}), handler)
+}
+
+// matchBusinessConnection returns a route condition comparing the update's business connection with connectionID.
+func matchBusinessConnection(connectionID string, connection func(update Update) *string) func(update Update) bool {
+	if connectionID == "" {
+		return nil
+	}
+	return func(update Update) bool {
+		id := connection(update)
+		return id != nil && *id == connectionID
+	}
+}
+
+// Subscribe adds update types to those reported by UpdateTypes without registering a route, for updates that are
+// only consumed by middlewares, such as the reactions recorded by a ReactionAggregator.
+func (r *Router) Subscribe(updateTypes ...UpdateType) {
+	r.subscribed = append(r.subscribed, updateTypes...)
+}
+
+// UpdateTypes returns the update types the router handles, to be passed as allowed_updates: the types of all registered routes
+// and those added with Subscribe. A router with a NotFound handler handles every update type. A router that handles nothing
+// returns nil, which leaves the allowed_updates set by earlier getUpdates or setWebhook calls unchanged, for polling and
+// webhooks alike; an empty list would select Telegram's default set instead.
+//
+// Telegram sends chat_member, message_reaction and message_reaction_count updates only if they are listed explicitly,
+// so allowed_updates computed from the router also makes sure those updates arrive when the router handles them.
+func (r *Router) UpdateTypes() []UpdateType {
+	if r.NotFound != nil {
+		return append([]UpdateType(nil), AllUpdateTypes...)
+	}
+
+	handled := make(map[UpdateType]bool)
+	for _, route := range r.routes {
+		handled[route.updateType] = true
+	}
+	for _, updateType := range r.subscribed {
+		handled[updateType] = true
+	}
+
+	var updateTypes []UpdateType
+	for _, updateType := range AllUpdateTypes {
+		if handled[updateType] {
+			updateTypes = append(updateTypes, updateType)
+		}
+	}
+	return updateTypes
+}
+
+// MissingUpdateTypes returns the update types the router handles that allowedUpdates doesn't include. Telegram treats
+// an empty list as all update types except chat_member, message_reaction and message_reaction_count.
+func (r *Router) MissingUpdateTypes(allowedUpdates []UpdateType) []UpdateType {
+	if len(allowedUpdates) == 0 {
+		allowedUpdates = defaultUpdateTypes()
+	}
+
+	allowed := make(map[UpdateType]bool)
+	for _, updateType := range allowedUpdates {
+		allowed[updateType] = true
+	}
+
+	var missing []UpdateType
+	for _, updateType := range r.UpdateTypes() {
+		if !allowed[updateType] {
+			missing = append(missing, updateType)
+		}
+	}
+	return missing
+}
+
+// SetWebhook sets the bot's webhook like Bot.SetWebhook, passing the update types the router handles as allowed_updates
+// unless request.AllowedUpdates is set. If it is set and misses update types the router handles, a warning is logged.
+func (r *Router) SetWebhook(bot *Bot, request SetWebhookRequest) error {
+	if request.AllowedUpdates == nil {
+		request.AllowedUpdates = r.UpdateTypes()
+	} else {
+		warnMissingUpdateTypes(r.MissingUpdateTypes(request.AllowedUpdates))
+	}
+	return bot.SetWebhook(request)
+}
+
+// HandleUpdate runs the router's middlewares and the first matching handler for the update.
+// The method value r.HandleUpdate can be used wherever a HandlerFunc is expected.
+//
+// Business connections and business messages seen by the router are remembered by the bot, so that replies to business
+// messages are sent on behalf of the business account automatically.
+func (r *Router) HandleUpdate(bot *Bot, update Update) error {
+	bot.businessConnections.observe(update)
+	return Chain(r.dispatch, r.middlewares...)(bot, update)
+}
+
+// dispatch calls the first handler whose route matches the update.
+func (r *Router) dispatch(bot *Bot, update Update) error {
+	kind := update.Kind()
+	for _, route := range r.routes {
+		if route.updateType != kind {
+			continue
+		}
+		if route.match != nil && !route.match(update) {
+			continue
+		}
+		if route.handlerMaybe != nil {
+			if handled, err := route.handlerMaybe(bot, update); handled || err != nil {
+				return err
+			}
+			continue
+		}
+		return route.handler(bot, update)
+	}
+
+	if r.NotFound != nil {
+		return r.NotFound(bot, update)
+	}
+	return nil
+}
+
+// defaultUpdateTypes returns the update types Telegram sends when allowed_updates is empty.
+func defaultUpdateTypes() []UpdateType {
+	var updateTypes []UpdateType
+	for _, updateType := range AllUpdateTypes {
+		switch updateType {
+		case UpdateTypeChatMember, UpdateTypeMessageReaction, UpdateTypeMessageReactionCount:
+		default:
+			updateTypes = append(updateTypes, updateType)
+		}
+	}
+	return updateTypes
+}
+
+// warnMissingUpdateTypes logs a warning about handled update types that Telegram won't send.
+func warnMissingUpdateTypes(missing []UpdateType) {
+	if len(missing) > 0 {
+		log.Printf("telegram: handlers are registered for update types %v, which are not in allowed_updates and won't be received", missing)
+	}
+}
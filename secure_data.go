@@ -0,0 +1,69 @@
+package telegram
+
+// SecureData represents the credentials required to decrypt encrypted data. All fields are optional and depend on fields that were requested.
+//
+// See "SecureData" https://core.telegram.org/passport#securedata
+type SecureData struct {
+	// (Optional) Credentials for encrypted personal details.
+	PersonalDetails *SecureValue `json:"personal_details,omitempty"`
+
+	// (Optional) Credentials for encrypted passport.
+	Passport *SecureValue `json:"passport,omitempty"`
+
+	// (Optional) Credentials for encrypted internal passport.
+	InternalPassport *SecureValue `json:"internal_passport,omitempty"`
+
+	// (Optional) Credentials for encrypted driver license.
+	DriverLicense *SecureValue `json:"driver_license,omitempty"`
+
+	// (Optional) Credentials for encrypted ID card.
+	IdentityCard *SecureValue `json:"identity_card,omitempty"`
+
+	// (Optional) Credentials for encrypted residential address.
+	Address *SecureValue `json:"address,omitempty"`
+
+	// (Optional) Credentials for encrypted utility bill.
+	UtilityBill *SecureValue `json:"utility_bill,omitempty"`
+
+	// (Optional) Credentials for encrypted bank statement.
+	BankStatement *SecureValue `json:"bank_statement,omitempty"`
+
+	// (Optional) Credentials for encrypted rental agreement.
+	RentalAgreement *SecureValue `json:"rental_agreement,omitempty"`
+
+	// (Optional) Credentials for encrypted registration from internal passport.
+	PassportRegistration *SecureValue `json:"passport_registration,omitempty"`
+
+	// (Optional) Credentials for encrypted temporary registration.
+	TemporaryRegistration *SecureValue `json:"temporary_registration,omitempty"`
+}
+
+// Value returns the credentials for the Telegram Passport element of the given type, or nil if there are none.
+func (d SecureData) Value(elementType string) *SecureValue {
+	switch elementType {
+	case "personal_details":
+		return d.PersonalDetails
+	case "passport":
+		return d.Passport
+	case "internal_passport":
+		return d.InternalPassport
+	case "driver_license":
+		return d.DriverLicense
+	case "identity_card":
+		return d.IdentityCard
+	case "address":
+		return d.Address
+	case "utility_bill":
+		return d.UtilityBill
+	case "bank_statement":
+		return d.BankStatement
+	case "rental_agreement":
+		return d.RentalAgreement
+	case "passport_registration":
+		return d.PassportRegistration
+	case "temporary_registration":
+		return d.TemporaryRegistration
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,29 @@
+package telegram
+
+// SecureValue represents the credentials required to decrypt encrypted values. All fields are optional and depend on the type of fields requested.
+//
+// See "SecureValue" https://core.telegram.org/passport#securevalue
+type SecureValue struct {
+	// (Optional) Credentials for encrypted Telegram Passport data. Available for “personal_details”, “passport”, “driver_license”,
+	// “identity_card”, “internal_passport” and “address” types.
+	Data *DataCredentials `json:"data,omitempty"`
+
+	// (Optional) Credentials for an encrypted document's front side. Available for “passport”, “driver_license”, “identity_card”
+	// and “internal_passport”.
+	FrontSide *FileCredentials `json:"front_side,omitempty"`
+
+	// (Optional) Credentials for an encrypted document's reverse side. Available for “driver_license” and “identity_card”.
+	ReverseSide *FileCredentials `json:"reverse_side,omitempty"`
+
+	// (Optional) Credentials for an encrypted selfie of the user with a document. Available for “passport”, “driver_license”,
+	// “identity_card” and “internal_passport”.
+	Selfie *FileCredentials `json:"selfie,omitempty"`
+
+	// (Optional) Credentials for an encrypted translation of the document. Available for “passport”, “driver_license”, “identity_card”,
+	// “internal_passport”, “utility_bill”, “bank_statement”, “rental_agreement”, “passport_registration” and “temporary_registration”.
+	Translation []FileCredentials `json:"translation,omitempty"`
+
+	// (Optional) Credentials for encrypted files. Available for “utility_bill”, “bank_statement”, “rental_agreement”,
+	// “passport_registration” and “temporary_registration” types.
+	Files []FileCredentials `json:"files,omitempty"`
+}
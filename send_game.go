@@ -0,0 +1,88 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SendGameRequest represents a request to send a game.
+//
+// See "sendGame" https://core.telegram.org/bots/api#sendgame
+type SendGameRequest struct {
+	// (Optional) Unique identifier of the business connection on behalf of which the message will be sent.
+	// If not set and ReplyParameters refers to a business message the bot received in the same chat, that message's connection is used.
+	BusinessConnectionID *string `json:"business_connection_id,omitempty"`
+
+	// (Required) Unique identifier for the target chat.
+	ChatID int64 `json:"chat_id"`
+
+	// (Optional) Unique identifier for the target message thread (topic) of the forum; for forum supergroups only.
+	MessageThreadID *int `json:"message_thread_id,omitempty"`
+
+	// (Required) Short name of the game, serves as the unique identifier for the game. Set up your games via @BotFather.
+	GameShortName string `json:"game_short_name"`
+
+	// (Optional) Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+
+	// (Optional) Protects the contents of the sent message from forwarding and saving.
+	ProtectContent *bool `json:"protect_content,omitempty"`
+
+	// (Optional) Pass True to allow up to 1000 messages per second, ignoring broadcasting limits for a fee of 0.1 Telegram Stars
+	// per message. The relevant Stars will be withdrawn from the bot's balance.
+	AllowPaidBroadcast *bool `json:"allow_paid_broadcast,omitempty"`
+
+	// (Optional) Unique identifier of the message effect to be added to the message; for private chats only.
+	MessageEffectID *string `json:"message_effect_id,omitempty"`
+
+	// (Optional) Description of the message to reply to.
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+
+	// (Optional) A JSON-serialized object for an inline keyboard. If empty, one 'Play game_title' button will be shown.
+	// If not empty, the first button must launch the game.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendGame sends a game. On success, the sent Message is returned.
+//
+// See "sendGame" https://core.telegram.org/bots/api#sendgame
+func (b *Bot) SendGame(request SendGameRequest) (Message, error) {
+	businessConnectionID, err := b.businessConnectionForReply(request.BusinessConnectionID, request.ChatID, request.ReplyParameters)
+	if err != nil {
+		return Message{}, err
+	}
+	request.BusinessConnectionID = businessConnectionID
+
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return Message{}, fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "sendGame", requestPayload)
+	if err != nil {
+		return Message{}, err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      Message            `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return Message{}, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return Message{}, fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return response.Result, nil
+}
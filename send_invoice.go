@@ -0,0 +1,146 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SendInvoiceRequest represents a request to send an invoice.
+//
+// See "sendInvoice" https://core.telegram.org/bots/api#sendinvoice
+type SendInvoiceRequest struct {
+	// (Required) Unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID interface{} `json:"chat_id"` // Using interface{} to allow both Integer and String types.
+
+	// (Optional) Unique identifier for the target message thread (topic) of the forum; for forum supergroups only.
+	MessageThreadID *int `json:"message_thread_id,omitempty"`
+
+	// (Required) Product name, 1-32 characters.
+	Title string `json:"title"`
+
+	// (Required) Product description, 1-255 characters.
+	Description string `json:"description"`
+
+	// (Required) Bot-defined invoice payload, 1-128 bytes. This will not be displayed to the user, use it for your internal processes.
+	Payload string `json:"payload"`
+
+	// (Optional) Payment provider token, obtained via @BotFather. Pass an empty string for payments in Telegram Stars.
+	ProviderToken *string `json:"provider_token,omitempty"`
+
+	// (Required) Three-letter ISO 4217 currency code, see more on currencies. Pass “XTR” for payments in Telegram Stars.
+	Currency string `json:"currency"`
+
+	// (Required) Price breakdown, a JSON-serialized list of components (e.g. product price, tax, discount, delivery cost, delivery tax,
+	// bonus, etc.). Must contain exactly one item for payments in Telegram Stars.
+	Prices []LabeledPrice `json:"prices"`
+
+	// (Optional) The maximum accepted amount for tips in the smallest units of the currency (integer, not float/double). For example,
+	// for a maximum tip of US$ 1.45 pass max_tip_amount = 145. Defaults to 0. Not supported for payments in Telegram Stars.
+	MaxTipAmount *int `json:"max_tip_amount,omitempty"`
+
+	// (Optional) A JSON-serialized array of suggested amounts of tips in the smallest units of the currency (integer, not float/double).
+	// At most 4 suggested tip amounts can be specified. The suggested tip amounts must be positive, passed in a strictly increased order
+	// and must not exceed max_tip_amount.
+	SuggestedTipAmounts []int `json:"suggested_tip_amounts,omitempty"`
+
+	// (Optional) Unique deep-linking parameter. If left empty, forwarded copies of the sent message will have a Pay button, allowing
+	// multiple users to pay directly from the forwarded message, using the same invoice. If non-empty, forwarded copies of the sent message
+	// will have a URL button with a deep link to the bot (instead of a Pay button), with the value used as the start parameter.
+	StartParameter *string `json:"start_parameter,omitempty"`
+
+	// (Optional) JSON-serialized data about the invoice, which will be shared with the payment provider. A detailed description of
+	// required fields should be provided by the payment provider.
+	ProviderData *string `json:"provider_data,omitempty"`
+
+	// (Optional) URL of the product photo for the invoice. Can be a photo of the goods or a marketing image for a service.
+	// People like it better when they see what they are paying for.
+	PhotoURL *string `json:"photo_url,omitempty"`
+
+	// (Optional) Photo size in bytes.
+	PhotoSize *int `json:"photo_size,omitempty"`
+
+	// (Optional) Photo width.
+	PhotoWidth *int `json:"photo_width,omitempty"`
+
+	// (Optional) Photo height.
+	PhotoHeight *int `json:"photo_height,omitempty"`
+
+	// (Optional) Pass True if you require the user's full name to complete the order. Ignored for payments in Telegram Stars.
+	NeedName *bool `json:"need_name,omitempty"`
+
+	// (Optional) Pass True if you require the user's phone number to complete the order. Ignored for payments in Telegram Stars.
+	NeedPhoneNumber *bool `json:"need_phone_number,omitempty"`
+
+	// (Optional) Pass True if you require the user's email address to complete the order. Ignored for payments in Telegram Stars.
+	NeedEmail *bool `json:"need_email,omitempty"`
+
+	// (Optional) Pass True if you require the user's shipping address to complete the order. Ignored for payments in Telegram Stars.
+	NeedShippingAddress *bool `json:"need_shipping_address,omitempty"`
+
+	// (Optional) Pass True if the user's phone number should be sent to the provider. Ignored for payments in Telegram Stars.
+	SendPhoneNumberToProvider *bool `json:"send_phone_number_to_provider,omitempty"`
+
+	// (Optional) Pass True if the user's email address should be sent to the provider. Ignored for payments in Telegram Stars.
+	SendEmailToProvider *bool `json:"send_email_to_provider,omitempty"`
+
+	// (Optional) Pass True if the final price depends on the shipping method. Ignored for payments in Telegram Stars.
+	IsFlexible *bool `json:"is_flexible,omitempty"`
+
+	// (Optional) Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+
+	// (Optional) Protects the contents of the sent message from forwarding and saving.
+	ProtectContent *bool `json:"protect_content,omitempty"`
+
+	// (Optional) Pass True to allow up to 1000 messages per second, ignoring broadcasting limits for a fee of 0.1 Telegram Stars
+	// per message. The relevant Stars will be withdrawn from the bot's balance.
+	AllowPaidBroadcast *bool `json:"allow_paid_broadcast,omitempty"`
+
+	// (Optional) Unique identifier of the message effect to be added to the message; for private chats only.
+	MessageEffectID *string `json:"message_effect_id,omitempty"`
+
+	// (Optional) Description of the message to reply to.
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+
+	// (Optional) A JSON-serialized object for an inline keyboard. If empty, one 'Pay total price' button will be shown.
+	// If not empty, the first button must be a Pay button.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendInvoice sends an invoice. On success, the sent Message is returned.
+//
+// See "sendInvoice" https://core.telegram.org/bots/api#sendinvoice
+func (b *Bot) SendInvoice(request SendInvoiceRequest) (Message, error) {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return Message{}, fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "sendInvoice", requestPayload)
+	if err != nil {
+		return Message{}, err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      Message            `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return Message{}, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return Message{}, fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return response.Result, nil
+}
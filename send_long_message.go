@@ -0,0 +1,54 @@
+package telegram
+
+import "fmt"
+
+// SendLongMessage sends a text message that may exceed MaxMessageTextLength as several messages, split with SplitText.
+//
+// Text formatted with parse_mode “HTML” or “MarkdownV2” is parsed locally first, so that the parts can be sent with entities.
+// The chunks are sent in order; only the first one replies to ReplyParameters, and ReplyMarkup is attached to the last one.
+// If a chunk fails to send, the messages sent so far are returned along with the error.
+func (b *Bot) SendLongMessage(request SendMessageRequest) ([]Message, error) {
+	if request.ParseMode != nil {
+		var err error
+		switch *request.ParseMode {
+		case "HTML":
+			request.Text, request.Entities, err = ParseHTML(request.Text)
+		case "MarkdownV2":
+			request.Text, request.Entities, err = ParseMarkdownV2(request.Text)
+		default:
+			err = fmt.Errorf("parse mode %q is not supported for long messages", *request.ParseMode)
+		}
+		if err != nil {
+			return nil, err
+		}
+		request.ParseMode = nil
+	}
+
+	// Every part is sent on behalf of the same business account as the reply.
+	businessConnectionID, err := b.businessConnectionForReply(request.BusinessConnectionID, request.ChatID, request.ReplyParameters)
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := SplitText(request.Text, request.Entities, MaxMessageTextLength)
+	messages := make([]Message, 0, len(chunks))
+	for i, chunk := range chunks {
+		part := request
+		part.BusinessConnectionID = businessConnectionID
+		part.Text = chunk.Text
+		part.Entities = chunk.Entities
+		if i > 0 {
+			part.ReplyParameters = nil
+		}
+		if i < len(chunks)-1 {
+			part.ReplyMarkup = nil
+		}
+
+		message, err := b.SendMessage(part)
+		if err != nil {
+			return messages, fmt.Errorf("error sending part %d of %d: %w", i+1, len(chunks), err)
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
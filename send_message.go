@@ -0,0 +1,97 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SendMessageRequest represents a request to send a text message.
+//
+// See "sendMessage" https://core.telegram.org/bots/api#sendmessage
+type SendMessageRequest struct {
+	// (Optional) Unique identifier of the business connection on behalf of which the message will be sent.
+	// If not set and ReplyParameters refers to a business message the bot received in the same chat, that message's connection is used.
+	BusinessConnectionID *string `json:"business_connection_id,omitempty"`
+
+	// (Required) Unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID interface{} `json:"chat_id"` // Using interface{} to allow both Integer and String types.
+
+	// (Optional) Unique identifier for the target message thread (topic) of the forum; for forum supergroups only.
+	MessageThreadID *int `json:"message_thread_id,omitempty"`
+
+	// (Required) Text of the message to be sent, 1-4096 characters after entities parsing.
+	Text string `json:"text"`
+
+	// (Optional) Mode for parsing entities in the message text. See formatting options for more details.
+	ParseMode *string `json:"parse_mode,omitempty"`
+
+	// (Optional) A JSON-serialized list of special entities that appear in message text, which can be specified instead of parse_mode.
+	Entities []MessageEntity `json:"entities,omitempty"`
+
+	// (Optional) Link preview generation options for the message.
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+
+	// (Optional) Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification *bool `json:"disable_notification,omitempty"`
+
+	// (Optional) Protects the contents of the sent message from forwarding and saving.
+	ProtectContent *bool `json:"protect_content,omitempty"`
+
+	// (Optional) Pass True to allow up to 1000 messages per second, ignoring broadcasting limits for a fee of 0.1 Telegram Stars
+	// per message. The relevant Stars will be withdrawn from the bot's balance.
+	AllowPaidBroadcast *bool `json:"allow_paid_broadcast,omitempty"`
+
+	// (Optional) Unique identifier of the message effect to be added to the message; for private chats only.
+	MessageEffectID *string `json:"message_effect_id,omitempty"`
+
+	// (Optional) Description of the message to reply to.
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+
+	// (Optional) Additional interface options. An inline keyboard, custom reply keyboard, instructions to remove a reply keyboard
+	// or to force a reply from the user.
+	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendMessage sends text messages. On success, the sent Message is returned.
+//
+// See "sendMessage" https://core.telegram.org/bots/api#sendmessage
+func (b *Bot) SendMessage(request SendMessageRequest) (Message, error) {
+	businessConnectionID, err := b.businessConnectionForReply(request.BusinessConnectionID, request.ChatID, request.ReplyParameters)
+	if err != nil {
+		return Message{}, err
+	}
+	request.BusinessConnectionID = businessConnectionID
+
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return Message{}, fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "sendMessage", requestPayload)
+	if err != nil {
+		return Message{}, err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      Message            `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return Message{}, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return Message{}, fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return response.Result, nil
+}
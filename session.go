@@ -0,0 +1,81 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Session is the data a SessionManager loaded for the update being handled. Values are stored JSON-encoded under names.
+// Changes are saved once the handler returns.
+type Session struct {
+	// Key the session is stored under.
+	Key string
+
+	values  map[string]json.RawMessage
+	changed map[string]bool
+	version uint64
+}
+
+// Get decodes the value stored under name into value. It reports whether a value is stored under name.
+func (s *Session) Get(name string, value interface{}) (bool, error) {
+	encoded, ok := s.values[name]
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal(encoded, value); err != nil {
+		return true, fmt.Errorf("error decoding session value %q: %w", name, err)
+	}
+	return true, nil
+}
+
+// Set stores value, encoded as JSON, under name.
+func (s *Session) Set(name string, value interface{}) error {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("error encoding session value %q: %w", name, err)
+	}
+	s.values[name] = encoded
+	s.changed[name] = true
+	return nil
+}
+
+// Delete removes the value stored under name.
+func (s *Session) Delete(name string) {
+	delete(s.values, name)
+	s.changed[name] = true
+}
+
+// Changed reports whether the session was modified since it was loaded.
+func (s *Session) Changed() bool {
+	return len(s.changed) > 0
+}
+
+// rebase replaces the session's values with those of a newer version of the session, keeping the values changed since
+// the session was loaded.
+func (s *Session) rebase(record SessionRecord) error {
+	values, err := decodeSessionValues(record.Data)
+	if err != nil {
+		return err
+	}
+	for name := range s.changed {
+		if value, ok := s.values[name]; ok {
+			values[name] = value
+		} else {
+			delete(values, name)
+		}
+	}
+	s.values, s.version = values, record.Version
+	return nil
+}
+
+// decodeSessionValues decodes the data of a stored session. Empty data yields no values.
+func decodeSessionValues(data []byte) (map[string]json.RawMessage, error) {
+	values := make(map[string]json.RawMessage)
+	if len(data) == 0 {
+		return values, nil
+	}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("error decoding session: %w", err)
+	}
+	return values, nil
+}
@@ -0,0 +1,153 @@
+package telegram
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// SessionKeyUser returns a session key per user, shared by all chats. It returns false for updates without a sender.
+func SessionKeyUser(update Update) (string, bool) {
+	sender := update.Sender()
+	if sender == nil {
+		return "", false
+	}
+	return "user:" + strconv.FormatInt(sender.ID, 10), true
+}
+
+// SessionKeyChat returns a session key per chat, shared by all its members. It returns false for updates without a chat.
+func SessionKeyChat(update Update) (string, bool) {
+	chat := update.Chat()
+	if chat == nil {
+		return "", false
+	}
+	return "chat:" + strconv.FormatInt(chat.ID, 10), true
+}
+
+// SessionKeyChatUser returns a session key per user and chat. It returns false for updates without a chat or sender.
+func SessionKeyChatUser(update Update) (string, bool) {
+	chat, sender := update.Chat(), update.Sender()
+	if chat == nil || sender == nil {
+		return "", false
+	}
+	return fmt.Sprintf("chat:%d:user:%d", chat.ID, sender.ID), true
+}
+
+// SessionManager loads the session of each update from a SessionStore before the handler runs and saves it afterwards.
+//
+// If the session was changed concurrently, e.g. by an update handled in parallel, the values the handler changed are applied
+// to the newer version and saving is retried, so changes to different values are never lost.
+type SessionManager struct {
+	// (Required) Store the sessions are kept in.
+	Store SessionStore
+
+	// (Required) Returns the key of the session of an update, or false if the update has none. NewSessionManager sets it
+	// to SessionKeyChatUser.
+	Key func(update Update) (string, bool)
+
+	// (Optional) Time after the last change after which a session expires. Sessions don't expire if it is zero.
+	TTL time.Duration
+
+	mu       sync.Mutex
+	sessions map[int]*Session
+}
+
+// NewSessionManager creates a manager keeping sessions per user and chat in store.
+func NewSessionManager(store SessionStore) *SessionManager {
+	return &SessionManager{Store: store, Key: SessionKeyChatUser}
+}
+
+// Middleware returns a middleware that loads the update's session, which handlers get with Session, and saves it
+// if the handler changed it. The session is saved even if the handler returns an error.
+func (m *SessionManager) Middleware() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(bot *Bot, update Update) error {
+			key, ok := m.Key(update)
+			if !ok {
+				return next(bot, update)
+			}
+
+			session, err := m.load(key)
+			if err != nil {
+				return err
+			}
+
+			m.mu.Lock()
+			if m.sessions == nil {
+				m.sessions = make(map[int]*Session)
+			}
+			m.sessions[update.UpdateID] = session
+			m.mu.Unlock()
+
+			defer func() {
+				m.mu.Lock()
+				delete(m.sessions, update.UpdateID)
+				m.mu.Unlock()
+			}()
+
+			handlerErr := next(bot, update)
+			return errors.Join(handlerErr, m.save(session))
+		}
+	}
+}
+
+// Session returns the session loaded for the update by the middleware, or nil if the update has none or isn't being
+// handled by the middleware.
+func (m *SessionManager) Session(update Update) *Session {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.sessions[update.UpdateID]
+}
+
+// load reads the session stored under key, or creates an empty one.
+func (m *SessionManager) load(key string) (*Session, error) {
+	session := &Session{Key: key, values: make(map[string]json.RawMessage), changed: make(map[string]bool)}
+
+	record, err := m.Store.Get(key)
+	if errors.Is(err, ErrSessionNotFound) {
+		return session, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return session, session.rebase(record)
+}
+
+// save stores the session if it was changed, rebasing the changes onto newer versions of the session on conflicts.
+func (m *SessionManager) save(session *Session) error {
+	if !session.Changed() {
+		return nil
+	}
+
+	for attempt := 0; attempt < maxSessionUpdateAttempts; attempt++ {
+		data, err := json.Marshal(session.values)
+		if err != nil {
+			return fmt.Errorf("error encoding session %q: %w", session.Key, err)
+		}
+
+		version, err := m.Store.Set(session.Key, data, session.version, m.TTL)
+		if err == nil {
+			session.version = version
+			session.changed = make(map[string]bool)
+			return nil
+		}
+		if !errors.Is(err, ErrSessionConflict) {
+			return err
+		}
+
+		record, err := m.Store.Get(session.Key)
+		if errors.Is(err, ErrSessionNotFound) {
+			record = SessionRecord{}
+		} else if err != nil {
+			return err
+		}
+		if err := session.rebase(record); err != nil {
+			return err
+		}
+	}
+	return fmt.Errorf("error saving session %q after %d attempts: %w", session.Key, maxSessionUpdateAttempts, ErrSessionConflict)
+}
@@ -0,0 +1,175 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrSessionNotFound is returned by a SessionStore when no unexpired session is stored under the requested key.
+	ErrSessionNotFound = errors.New("session not found or expired")
+
+	// ErrSessionConflict is returned by a SessionStore when a session is written with a version other than the stored one,
+	// i.e. when it was changed since it was read.
+	ErrSessionConflict = errors.New("session was modified concurrently")
+)
+
+// maxSessionUpdateAttempts bounds the retries of UpdateSession after conflicting writes.
+const maxSessionUpdateAttempts = 8
+
+// SessionRecord is a session as stored in a SessionStore.
+type SessionRecord struct {
+	// Encoded session data.
+	Data []byte `json:"data"`
+
+	// Version of the session, changed by every write. Sessions that don't exist have version 0.
+	Version uint64 `json:"version"`
+
+	// Time after which the session expires, or the zero time if it never does.
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// SessionStore keeps session data that survives across updates. Writes use optimistic locking: a write only succeeds if the
+// session still has the version it was read with. Implementations must be safe for concurrent use.
+//
+// Versions are taken from a counter shared by all keys of a store that never decreases, not even across restarts. A new
+// session under the key of an expired or deleted one therefore never gets a version the earlier session had, so that a writer
+// holding a version read before can never overwrite the new session, and expired and deleted sessions can be removed outright.
+type SessionStore interface {
+	// Get returns the session stored under key, or ErrSessionNotFound if there is none or it has expired.
+	Get(key string) (SessionRecord, error)
+
+	// Set stores data under key if the stored session has the given version, using 0 for sessions that don't exist, and returns
+	// the new version. It returns ErrSessionConflict otherwise. The session expires after ttl, or never if ttl is zero.
+	Set(key string, data []byte, version uint64, ttl time.Duration) (uint64, error)
+
+	// Delete removes the session stored under key. Deleting a missing session is not an error.
+	Delete(key string) error
+}
+
+// UpdateSession reads the session stored under key, passes its data to update, nil if there is none, and stores the result,
+// retrying from the start if the session was changed in the meantime. The session expires after ttl, or never if ttl is zero.
+func UpdateSession(store SessionStore, key string, ttl time.Duration, update func(data []byte) ([]byte, error)) error {
+	for attempt := 0; attempt < maxSessionUpdateAttempts; attempt++ {
+		record, err := store.Get(key)
+		if err != nil && !errors.Is(err, ErrSessionNotFound) {
+			return err
+		}
+
+		data, err := update(record.Data)
+		if err != nil {
+			return err
+		}
+
+		_, err = store.Set(key, data, record.Version, ttl)
+		if !errors.Is(err, ErrSessionConflict) {
+			return err
+		}
+	}
+	return fmt.Errorf("error updating session %q after %d attempts: %w", key, maxSessionUpdateAttempts, ErrSessionConflict)
+}
+
+// sessionExpiry returns the time a session written at now with ttl expires.
+func sessionExpiry(now time.Time, ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return now.Add(ttl)
+}
+
+// sessionExpired reports whether a session expiring at expiresAt has expired at now.
+func sessionExpired(expiresAt, now time.Time) bool {
+	return !expiresAt.IsZero() && !now.Before(expiresAt)
+}
+
+// currentSessionVersion returns the version a write must be based on to replace stored: its version if it exists and hasn't
+// expired at now, and 0 otherwise.
+func currentSessionVersion(stored SessionRecord, ok bool, now time.Time) uint64 {
+	if !ok || sessionExpired(stored.ExpiresAt, now) {
+		return 0
+	}
+	return stored.Version
+}
+
+// MemorySessionStore is an in-process SessionStore. Sessions are lost when the process exits.
+//
+// Expired sessions are removed when they are read, or by Sweep, which should be called periodically if many keys are never
+// read again after their sessions expire.
+type MemorySessionStore struct {
+	// (Optional) Returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	mu       sync.Mutex
+	sessions map[string]SessionRecord
+	version  uint64
+}
+
+// NewMemorySessionStore creates an empty in-memory store.
+func NewMemorySessionStore() *MemorySessionStore {
+	return &MemorySessionStore{sessions: make(map[string]SessionRecord)}
+}
+
+// Get returns the session stored under key.
+func (s *MemorySessionStore) Get(key string) (SessionRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.sessions[key]
+	if !ok {
+		return SessionRecord{}, ErrSessionNotFound
+	}
+	if sessionExpired(record.ExpiresAt, s.now()) {
+		delete(s.sessions, key)
+		return SessionRecord{}, ErrSessionNotFound
+	}
+	record.Data = append([]byte(nil), record.Data...)
+	return record, nil
+}
+
+// Set stores data under key if the stored session has the given version.
+func (s *MemorySessionStore) Set(key string, data []byte, version uint64, ttl time.Duration) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	stored, ok := s.sessions[key]
+	if version != currentSessionVersion(stored, ok, now) {
+		return 0, ErrSessionConflict
+	}
+
+	s.version++
+	s.sessions[key] = SessionRecord{Data: append([]byte(nil), data...), Version: s.version, ExpiresAt: sessionExpiry(now, ttl)}
+	return s.version, nil
+}
+
+// Delete removes the session stored under key.
+func (s *MemorySessionStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sessions, key)
+	return nil
+}
+
+// Sweep removes the sessions that have expired.
+func (s *MemorySessionStore) Sweep() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for key, record := range s.sessions {
+		if sessionExpired(record.ExpiresAt, now) {
+			delete(s.sessions, key)
+		}
+	}
+}
+
+// now returns the current time.
+func (s *MemorySessionStore) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
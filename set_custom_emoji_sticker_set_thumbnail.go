@@ -0,0 +1,56 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SetCustomEmojiStickerSetThumbnailRequest represents a request to set the thumbnail of a custom emoji sticker set.
+//
+// See "setCustomEmojiStickerSetThumbnail" https://core.telegram.org/bots/api#setcustomemojistickersetthumbnail
+type SetCustomEmojiStickerSetThumbnailRequest struct {
+	// (Required) Sticker set name.
+	Name string `json:"name"`
+
+	// (Optional) Custom emoji identifier of a sticker from the sticker set; pass an empty string to drop the thumbnail and use
+	// the first sticker as the thumbnail.
+	CustomEmojiID *string `json:"custom_emoji_id,omitempty"`
+}
+
+// SetCustomEmojiStickerSetThumbnail sets the thumbnail of a custom emoji sticker set.
+//
+// See "setCustomEmojiStickerSetThumbnail" https://core.telegram.org/bots/api#setcustomemojistickersetthumbnail
+func (b *Bot) SetCustomEmojiStickerSetThumbnail(request SetCustomEmojiStickerSetThumbnailRequest) error {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "setCustomEmojiStickerSetThumbnail", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SetGameScoreRequest represents a request to set the score of the specified user in a game message.
+//
+// See "setGameScore" https://core.telegram.org/bots/api#setgamescore
+type SetGameScoreRequest struct {
+	// (Required) User identifier.
+	UserID int64 `json:"user_id"`
+
+	// (Required) New score, must be non-negative.
+	Score int `json:"score"`
+
+	// (Optional) Pass True if the high score is allowed to decrease. This can be useful when fixing mistakes or banning cheaters.
+	Force *bool `json:"force,omitempty"`
+
+	// (Optional) Pass True if the game message should not be automatically edited to include the current scoreboard.
+	DisableEditMessage *bool `json:"disable_edit_message,omitempty"`
+
+	// (Optional) Required if inline_message_id is not specified. Unique identifier for the target chat.
+	ChatID *int64 `json:"chat_id,omitempty"`
+
+	// (Optional) Required if inline_message_id is not specified. Identifier of the sent message.
+	MessageID *int `json:"message_id,omitempty"`
+
+	// (Optional) Required if chat_id and message_id are not specified. Identifier of the inline message.
+	InlineMessageID *string `json:"inline_message_id,omitempty"`
+}
+
+// SetGameScore sets the score of the specified user in a game message. On success, if the message is not an inline message,
+// the edited Message is returned, otherwise nil is returned. Returns an error, if the new score is not greater than the user's
+// current score in the chat and force is False.
+//
+// See "setGameScore" https://core.telegram.org/bots/api#setgamescore
+func (b *Bot) SetGameScore(request SetGameScoreRequest) (*Message, error) {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return nil, fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "setGameScore", requestPayload)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      json.RawMessage    `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return nil, fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	// Inline messages are edited in place and the result is just True.
+	if bytes.Equal(bytes.TrimSpace(response.Result), []byte("true")) {
+		return nil, nil
+	}
+
+	var message Message
+	if err := json.Unmarshal(response.Result, &message); err != nil {
+		return nil, fmt.Errorf("error decoding edited message: %w", err)
+	}
+
+	return &message, nil
+}
@@ -0,0 +1,66 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SetMessageReactionRequest represents a request to change the chosen reactions on a message.
+//
+// See "setMessageReaction" https://core.telegram.org/bots/api#setmessagereaction
+type SetMessageReactionRequest struct {
+	// (Required) Unique identifier for the target chat or username of the target channel (in the format @channelusername).
+	ChatID interface{} `json:"chat_id"` // Using interface{} to allow both Integer and String types.
+
+	// (Required) Identifier of the target message. If the message belongs to a media group, the reaction is set to the first
+	// non-deleted message in the group instead.
+	MessageID int `json:"message_id"`
+
+	// (Optional) A JSON-serialized list of reaction types to set on the message. Currently, as non-premium users, bots can set up
+	// to one reaction per message. A custom emoji reaction can be used if it is either already present on the message or explicitly
+	// allowed by chat administrators. Paid reactions can't be used by bots.
+	Reaction []ReactionType `json:"reaction,omitempty"`
+
+	// (Optional) Pass True to set the reaction with a big animation.
+	IsBig *bool `json:"is_big,omitempty"`
+}
+
+// SetMessageReaction changes the chosen reactions on a message. Service messages of some types can't be reacted to.
+// Automatically forwarded messages from a channel to its discussion group have the same available reactions as messages in the channel.
+// Bots can't use paid reactions. Returns True on success.
+//
+// See "setMessageReaction" https://core.telegram.org/bots/api#setmessagereaction
+func (b *Bot) SetMessageReaction(request SetMessageReactionRequest) error {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "setMessageReaction", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SetPassportDataErrorsRequest represents a request to inform a user that some of the Telegram Passport elements they provided contains errors.
+//
+// See "setPassportDataErrors" https://core.telegram.org/bots/api#setpassportdataerrors
+type SetPassportDataErrorsRequest struct {
+	// (Required) User identifier.
+	UserID int64 `json:"user_id"`
+
+	// (Required) A JSON-serialized array describing the errors.
+	Errors []PassportElementError `json:"errors"`
+}
+
+// SetPassportDataErrors informs a user that some of the Telegram Passport elements they provided contains errors.
+// The user will not be able to re-submit their Passport to you until the errors are fixed (the contents of the field for which
+// you returned the error must change).
+//
+// Use this if the data submitted by the user doesn't satisfy the standards your service requires for any reason. For example,
+// if a birthday date seems invalid, a submitted document is blurry, a scan shows evidence of tampering, etc. Supply some details
+// in the error message to make sure the user knows how to correct the issues.
+//
+// See "setPassportDataErrors" https://core.telegram.org/bots/api#setpassportdataerrors
+func (b *Bot) SetPassportDataErrors(request SetPassportDataErrorsRequest) error {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "setPassportDataErrors", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
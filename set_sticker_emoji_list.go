@@ -0,0 +1,56 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SetStickerEmojiListRequest represents a request to change the list of emoji assigned to a regular or custom emoji sticker.
+//
+// See "setStickerEmojiList" https://core.telegram.org/bots/api#setstickeremojilist
+type SetStickerEmojiListRequest struct {
+	// (Required) File identifier of the sticker.
+	Sticker string `json:"sticker"`
+
+	// (Required) A JSON-serialized list of 1-20 emoji associated with the sticker.
+	EmojiList []string `json:"emoji_list"`
+}
+
+// SetStickerEmojiList changes the list of emoji assigned to a regular or custom emoji sticker.
+// The sticker must belong to a sticker set created by the bot.
+//
+// See "setStickerEmojiList" https://core.telegram.org/bots/api#setstickeremojilist
+func (b *Bot) SetStickerEmojiList(request SetStickerEmojiListRequest) error {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "setStickerEmojiList", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
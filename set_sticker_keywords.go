@@ -0,0 +1,56 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SetStickerKeywordsRequest represents a request to change search keywords assigned to a regular or custom emoji sticker.
+//
+// See "setStickerKeywords" https://core.telegram.org/bots/api#setstickerkeywords
+type SetStickerKeywordsRequest struct {
+	// (Required) File identifier of the sticker.
+	Sticker string `json:"sticker"`
+
+	// (Optional) A JSON-serialized list of 0-20 search keywords for the sticker with total length of up to 64 characters.
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+// SetStickerKeywords changes search keywords assigned to a regular or custom emoji sticker.
+// The sticker must belong to a sticker set created by the bot.
+//
+// See "setStickerKeywords" https://core.telegram.org/bots/api#setstickerkeywords
+func (b *Bot) SetStickerKeywords(request SetStickerKeywordsRequest) error {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "setStickerKeywords", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SetStickerMaskPositionRequest represents a request to change the mask position of a mask sticker.
+//
+// See "setStickerMaskPosition" https://core.telegram.org/bots/api#setstickermaskposition
+type SetStickerMaskPositionRequest struct {
+	// (Required) File identifier of the sticker.
+	Sticker string `json:"sticker"`
+
+	// (Optional) A JSON-serialized object with the position where the mask should be placed on faces. Omit the parameter to remove the mask position.
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
+}
+
+// SetStickerMaskPosition changes the mask position of a mask sticker. The sticker must belong to a sticker set that was created by the bot.
+//
+// See "setStickerMaskPosition" https://core.telegram.org/bots/api#setstickermaskposition
+func (b *Bot) SetStickerMaskPosition(request SetStickerMaskPositionRequest) error {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "setStickerMaskPosition", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SetStickerPositionInSetRequest represents a request to move a sticker in a set created by the bot to a specific position.
+//
+// See "setStickerPositionInSet" https://core.telegram.org/bots/api#setstickerpositioninset
+type SetStickerPositionInSetRequest struct {
+	// (Required) File identifier of the sticker.
+	Sticker string `json:"sticker"`
+
+	// (Required) New sticker position in the set, zero-based.
+	Position int `json:"position"`
+}
+
+// SetStickerPositionInSet moves a sticker in a set created by the bot to a specific position.
+//
+// See "setStickerPositionInSet" https://core.telegram.org/bots/api#setstickerpositioninset
+func (b *Bot) SetStickerPositionInSet(request SetStickerPositionInSetRequest) error {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "setStickerPositionInSet", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SetStickerSetThumbnailRequest represents a request to set the thumbnail of a regular or mask sticker set.
+//
+// See "setStickerSetThumbnail" https://core.telegram.org/bots/api#setstickersetthumbnail
+type SetStickerSetThumbnailRequest struct {
+	// (Required) Sticker set name.
+	Name string `json:"name"`
+
+	// (Required) User identifier of the sticker set owner.
+	UserID int64 `json:"user_id"`
+
+	// (Optional) A .WEBP or .PNG image with the thumbnail, must be up to 128 kilobytes in size and have a width and height of exactly 100px,
+	// or a .TGS animation, or a .WEBM video. Pass a file_id as a String to send a file that already exists on the Telegram servers, or pass
+	// an HTTP URL as a String for Telegram to get a file from the Internet. Animated and video sticker set thumbnails can't be uploaded via
+	// HTTP URL. If omitted, then the thumbnail is dropped and the first sticker is used as the thumbnail.
+	Thumbnail *string `json:"thumbnail,omitempty"`
+
+	// (Required) Format of the thumbnail, must be one of “static” for a .WEBP or .PNG image, “animated” for a .TGS animation,
+	// or “video” for a .WEBM video.
+	Format string `json:"format"`
+}
+
+// SetStickerSetThumbnail sets the thumbnail of a regular or mask sticker set. The format of the thumbnail file must match
+// the format of the stickers in the set.
+//
+// See "setStickerSetThumbnail" https://core.telegram.org/bots/api#setstickersetthumbnail
+func (b *Bot) SetStickerSetThumbnail(request SetStickerSetThumbnailRequest) error {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "setStickerSetThumbnail", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SetStickerSetTitleRequest represents a request to set the title of a created sticker set.
+//
+// See "setStickerSetTitle" https://core.telegram.org/bots/api#setstickersettitle
+type SetStickerSetTitleRequest struct {
+	// (Required) Sticker set name.
+	Name string `json:"name"`
+
+	// (Required) Sticker set title, 1-64 characters.
+	Title string `json:"title"`
+}
+
+// SetStickerSetTitle sets the title of a created sticker set.
+//
+// See "setStickerSetTitle" https://core.telegram.org/bots/api#setstickersettitle
+func (b *Bot) SetStickerSetTitle(request SetStickerSetTitleRequest) error {
+	requestPayload := new(bytes.Buffer)
+
+	if err := json.NewEncoder(requestPayload).Encode(request); err != nil {
+		return fmt.Errorf("error encoding request payload: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", "application/json", "setStickerSetTitle", requestPayload)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      bool               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return nil
+}
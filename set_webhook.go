@@ -42,7 +42,7 @@ type SetWebhookRequest struct {
 	// Specify an empty list to receive all update types except chat_member, message_reaction, and message_reaction_count (default).
 	// If not specified, the previous setting will be used.
 	// Please note that this parameter doesn't affect updates created before the call to the setWebhook, so unwanted updates may be received for a short period of time.
-	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+	AllowedUpdates []UpdateType `json:"allowed_updates,omitempty"`
 
 	// (Optional) Pass True to drop all pending updates.
 	DropPendingUpdates *bool `json:"drop_pending_updates,omitempty"`
@@ -0,0 +1,24 @@
+package telegram
+
+// ShippingAddress represents a shipping address.
+//
+// See "ShippingAddress" https://core.telegram.org/bots/api#shippingaddress
+type ShippingAddress struct {
+	// (Required) Two-letter ISO 3166-1 alpha-2 country code.
+	CountryCode string `json:"country_code"`
+
+	// (Required) State, if applicable.
+	State string `json:"state"`
+
+	// (Required) City.
+	City string `json:"city"`
+
+	// (Required) First line for the address.
+	StreetLine1 string `json:"street_line1"`
+
+	// (Required) Second line for the address.
+	StreetLine2 string `json:"street_line2"`
+
+	// (Required) Address post code.
+	PostCode string `json:"post_code"`
+}
@@ -0,0 +1,274 @@
+package telegram
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// starTransactionsPageSize is the number of transactions requested per getStarTransactions call during reconciliation.
+const starTransactionsPageSize = 100
+
+// StarLedgerEntry is the ledger's record of a payment in Telegram Stars.
+type StarLedgerEntry struct {
+	// Telegram payment identifier, shared by the payment and its refund.
+	TelegramPaymentChargeID string
+
+	// Identifier of the user who paid.
+	UserID int64
+
+	// Bot-specified invoice payload.
+	InvoicePayload string
+
+	// Number of Telegram Stars charged.
+	Amount int
+
+	// Number of Telegram Stars refunded, 0 if the payment wasn't refunded.
+	RefundedAmount int
+}
+
+// StarMismatchKind classifies a difference between the ledger and the transactions reported by Telegram.
+type StarMismatchKind string
+
+const (
+	// StarMismatchMissingAtTelegram means the ledger has a payment Telegram doesn't report.
+	StarMismatchMissingAtTelegram StarMismatchKind = "missing_at_telegram"
+
+	// StarMismatchMissingInLedger means Telegram reports a payment from a user the ledger never recorded.
+	StarMismatchMissingInLedger StarMismatchKind = "missing_in_ledger"
+
+	// StarMismatchAmount means the charged amounts differ.
+	StarMismatchAmount StarMismatchKind = "amount"
+
+	// StarMismatchRefund means the refunded amounts differ.
+	StarMismatchRefund StarMismatchKind = "refund"
+)
+
+// StarMismatch describes one payment on which the ledger and Telegram disagree.
+type StarMismatch struct {
+	Kind                    StarMismatchKind
+	TelegramPaymentChargeID string
+
+	// Amounts recorded in the ledger; zero if the ledger has no such payment.
+	LedgerAmount         int
+	LedgerRefundedAmount int
+
+	// Amounts reported by Telegram; zero if Telegram reports no such payment.
+	TelegramAmount         int
+	TelegramRefundedAmount int
+}
+
+// StarLedger keeps a local record of payments in Telegram Stars and reconciles it against getStarTransactions.
+//
+// Payments and refunds are recorded from the successful_payment and refunded_payment service messages, either directly
+// with Record or by installing Middleware on a router. Payments in other currencies are ignored. StarLedger is safe for concurrent use.
+type StarLedger struct {
+	mu      sync.Mutex
+	entries map[string]*StarLedgerEntry
+}
+
+// NewStarLedger creates an empty ledger.
+func NewStarLedger() *StarLedger {
+	return &StarLedger{entries: make(map[string]*StarLedgerEntry)}
+}
+
+// Middleware returns a middleware that records payments and refunds found in incoming updates before passing them on.
+func (l *StarLedger) Middleware() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(bot *Bot, update Update) error {
+			l.RecordUpdate(update)
+			return next(bot, update)
+		}
+	}
+}
+
+// RecordUpdate records the payment or refund carried by the update's message, if any.
+func (l *StarLedger) RecordUpdate(update Update) {
+	for _, message := range []*Message{update.Message, update.BusinessMessage} {
+		if message == nil {
+			continue
+		}
+
+		var userID int64
+		if message.From != nil {
+			userID = message.From.ID
+		}
+
+		if message.SuccessfulPayment != nil {
+			l.RecordPayment(userID, *message.SuccessfulPayment)
+		}
+		if message.RefundedPayment != nil {
+			l.RecordRefund(userID, *message.RefundedPayment)
+		}
+	}
+}
+
+// RecordPayment records a successful payment made by the user. Recording the same payment twice has no further effect.
+func (l *StarLedger) RecordPayment(userID int64, payment SuccessfulPayment) {
+	if payment.Currency != "XTR" {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry := l.entry(payment.TelegramPaymentChargeID)
+	entry.UserID = userID
+	entry.InvoicePayload = payment.InvoicePayload
+	entry.Amount = payment.TotalAmount
+}
+
+// RecordRefund records a refund of a payment made by the user.
+func (l *StarLedger) RecordRefund(userID int64, refund RefundedPayment) {
+	if refund.Currency != "XTR" {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry := l.entry(refund.TelegramPaymentChargeID)
+	if entry.UserID == 0 {
+		entry.UserID = userID
+	}
+	if entry.InvoicePayload == "" {
+		entry.InvoicePayload = refund.InvoicePayload
+	}
+	entry.RefundedAmount = refund.TotalAmount
+}
+
+// entry returns the entry for chargeID, creating it if needed. l.mu must be held.
+func (l *StarLedger) entry(chargeID string) *StarLedgerEntry {
+	entry, ok := l.entries[chargeID]
+	if !ok {
+		entry = &StarLedgerEntry{TelegramPaymentChargeID: chargeID}
+		l.entries[chargeID] = entry
+	}
+	return entry
+}
+
+// Entries returns a copy of all recorded payments ordered by charge identifier.
+func (l *StarLedger) Entries() []StarLedgerEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entries := make([]StarLedgerEntry, 0, len(l.entries))
+	for _, entry := range l.entries {
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].TelegramPaymentChargeID < entries[j].TelegramPaymentChargeID
+	})
+	return entries
+}
+
+// Reconcile pages through all of the bot's Telegram Star transactions and returns the payments on which the ledger and Telegram disagree.
+//
+// Only transactions with users are compared: incoming ones are payments, outgoing ones with the same identifier are their refunds.
+func (l *StarLedger) Reconcile(bot *Bot) ([]StarMismatch, error) {
+	reported, err := fetchStarPayments(bot)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.compare(reported), nil
+}
+
+// compare returns the differences between the ledger and the payments reported by Telegram.
+func (l *StarLedger) compare(reported map[string]*StarLedgerEntry) []StarMismatch {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var mismatches []StarMismatch
+	for chargeID, local := range l.entries {
+		remote, ok := reported[chargeID]
+		if !ok {
+			mismatches = append(mismatches, StarMismatch{
+				Kind:                    StarMismatchMissingAtTelegram,
+				TelegramPaymentChargeID: chargeID,
+				LedgerAmount:            local.Amount,
+				LedgerRefundedAmount:    local.RefundedAmount,
+			})
+			continue
+		}
+
+		mismatch := StarMismatch{
+			TelegramPaymentChargeID: chargeID,
+			LedgerAmount:            local.Amount,
+			LedgerRefundedAmount:    local.RefundedAmount,
+			TelegramAmount:          remote.Amount,
+			TelegramRefundedAmount:  remote.RefundedAmount,
+		}
+		switch {
+		case local.Amount != remote.Amount:
+			mismatch.Kind = StarMismatchAmount
+		case local.RefundedAmount != remote.RefundedAmount:
+			mismatch.Kind = StarMismatchRefund
+		default:
+			continue
+		}
+		mismatches = append(mismatches, mismatch)
+	}
+
+	for chargeID, remote := range reported {
+		if _, ok := l.entries[chargeID]; ok || remote.Amount == 0 {
+			continue
+		}
+		mismatches = append(mismatches, StarMismatch{
+			Kind:                    StarMismatchMissingInLedger,
+			TelegramPaymentChargeID: chargeID,
+			TelegramAmount:          remote.Amount,
+			TelegramRefundedAmount:  remote.RefundedAmount,
+		})
+	}
+
+	sort.Slice(mismatches, func(i, j int) bool {
+		return mismatches[i].TelegramPaymentChargeID < mismatches[j].TelegramPaymentChargeID
+	})
+	return mismatches
+}
+
+// fetchStarPayments pages through getStarTransactions and collects invoice payments from users and refunds by charge identifier.
+// Refunds of payments that aren't invoice payments are left without an amount, which compare ignores.
+func fetchStarPayments(bot *Bot) (map[string]*StarLedgerEntry, error) {
+	payments := make(map[string]*StarLedgerEntry)
+	payment := func(chargeID string) *StarLedgerEntry {
+		entry, ok := payments[chargeID]
+		if !ok {
+			entry = &StarLedgerEntry{TelegramPaymentChargeID: chargeID}
+			payments[chargeID] = entry
+		}
+		return entry
+	}
+
+	offset, limit := 0, starTransactionsPageSize
+	for {
+		page, err := bot.GetStarTransactions(GetStarTransactionsRequest{Offset: &offset, Limit: &limit})
+		if err != nil {
+			return nil, fmt.Errorf("error fetching star transactions at offset %d: %w", offset, err)
+		}
+
+		for _, transaction := range page.Transactions {
+			switch {
+			case transaction.Source != nil && transaction.Source.User != nil:
+				// Paid media purchases and other transactions without an invoice produce no successful_payment,
+				// so the ledger never records them.
+				if transaction.Source.User.InvoicePayload == nil {
+					continue
+				}
+				entry := payment(transaction.ID)
+				entry.UserID = transaction.Source.User.User.ID
+				entry.Amount = transaction.Amount
+				entry.InvoicePayload = *transaction.Source.User.InvoicePayload
+			case transaction.Receiver != nil && transaction.Receiver.User != nil:
+				entry := payment(transaction.ID)
+				entry.RefundedAmount = transaction.Amount
+			}
+		}
+
+		if len(page.Transactions) < limit {
+			return payments, nil
+		}
+		offset += len(page.Transactions)
+	}
+}
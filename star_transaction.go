@@ -0,0 +1,27 @@
+package telegram
+
+// StarTransaction describes a Telegram Star transaction.
+//
+// See "StarTransaction" https://core.telegram.org/bots/api#startransaction
+type StarTransaction struct {
+	// (Required) Unique identifier of the transaction. Coincides with the identifier of the original transaction for refund transactions.
+	// Coincides with SuccessfulPayment.telegram_payment_charge_id for successful incoming payments from users.
+	ID string `json:"id"`
+
+	// (Required) Integer amount of Telegram Stars transferred by the transaction.
+	Amount int `json:"amount"`
+
+	// (Optional) The number of 1/1000000000 shares of Telegram Stars transferred by the transaction; from 0 to 999999999.
+	NanostarAmount *int `json:"nanostar_amount,omitempty"`
+
+	// (Required) Date the transaction was created in Unix time.
+	Date int `json:"date"`
+
+	// (Optional) Source of an incoming transaction (e.g., a user purchasing goods or services, Fragment refunding a failed withdrawal).
+	// Only for incoming transactions.
+	Source *TransactionPartner `json:"source,omitempty"`
+
+	// (Optional) Receiver of an outgoing transaction (e.g., a user for a purchase refund, Fragment for a withdrawal).
+	// Only for outgoing transactions.
+	Receiver *TransactionPartner `json:"receiver,omitempty"`
+}
@@ -0,0 +1,185 @@
+package telegram
+
+import (
+	"sort"
+	"strings"
+)
+
+// TextFormatter builds message text together with its formatting entities, so that text can be sent with
+// the entities (or caption_entities) parameter instead of a parse_mode and never needs escaping.
+//
+// Entity offsets and lengths are computed in UTF-16 code units. Styles can be nested with the Func variants, e.g.
+//
+//	text, entities := NewTextFormatter().
+//		Text("Hello, ").
+//		BoldFunc(func(f *TextFormatter) { f.Text("dear ").Italic("world") }).
+//		Text("!").
+//		Build()
+type TextFormatter struct {
+	text     strings.Builder
+	length   int
+	entities []MessageEntity
+}
+
+// NewTextFormatter creates an empty formatter.
+func NewTextFormatter() *TextFormatter {
+	return &TextFormatter{}
+}
+
+// Text appends plain text.
+func (f *TextFormatter) Text(text string) *TextFormatter {
+	f.text.WriteString(text)
+	f.length += utf16Length(text)
+	return f
+}
+
+// Bold appends bold text.
+func (f *TextFormatter) Bold(text string) *TextFormatter {
+	return f.BoldFunc(leaf(text))
+}
+
+// BoldFunc appends the text built by build in bold.
+func (f *TextFormatter) BoldFunc(build func(f *TextFormatter)) *TextFormatter {
+	return f.wrap(MessageEntity{Type: "bold"}, build)
+}
+
+// Italic appends italic text.
+func (f *TextFormatter) Italic(text string) *TextFormatter {
+	return f.ItalicFunc(leaf(text))
+}
+
+// ItalicFunc appends the text built by build in italic.
+func (f *TextFormatter) ItalicFunc(build func(f *TextFormatter)) *TextFormatter {
+	return f.wrap(MessageEntity{Type: "italic"}, build)
+}
+
+// Underline appends underlined text.
+func (f *TextFormatter) Underline(text string) *TextFormatter {
+	return f.UnderlineFunc(leaf(text))
+}
+
+// UnderlineFunc appends the text built by build underlined.
+func (f *TextFormatter) UnderlineFunc(build func(f *TextFormatter)) *TextFormatter {
+	return f.wrap(MessageEntity{Type: "underline"}, build)
+}
+
+// Strikethrough appends strikethrough text.
+func (f *TextFormatter) Strikethrough(text string) *TextFormatter {
+	return f.StrikethroughFunc(leaf(text))
+}
+
+// StrikethroughFunc appends the text built by build struck through.
+func (f *TextFormatter) StrikethroughFunc(build func(f *TextFormatter)) *TextFormatter {
+	return f.wrap(MessageEntity{Type: "strikethrough"}, build)
+}
+
+// Spoiler appends text hidden under a spoiler.
+func (f *TextFormatter) Spoiler(text string) *TextFormatter {
+	return f.SpoilerFunc(leaf(text))
+}
+
+// SpoilerFunc appends the text built by build hidden under a spoiler.
+func (f *TextFormatter) SpoilerFunc(build func(f *TextFormatter)) *TextFormatter {
+	return f.wrap(MessageEntity{Type: "spoiler"}, build)
+}
+
+// Code appends an inline monowidth string. Code can't contain other entities.
+func (f *TextFormatter) Code(text string) *TextFormatter {
+	return f.wrap(MessageEntity{Type: "code"}, leaf(text))
+}
+
+// Pre appends a monowidth block with an optional programming language. Pre blocks can't contain other entities.
+func (f *TextFormatter) Pre(text, language string) *TextFormatter {
+	entity := MessageEntity{Type: "pre"}
+	if language != "" {
+		entity.Language = &language
+	}
+	return f.wrap(entity, leaf(text))
+}
+
+// TextLink appends text that opens url when tapped.
+func (f *TextFormatter) TextLink(text, url string) *TextFormatter {
+	return f.TextLinkFunc(url, leaf(text))
+}
+
+// TextLinkFunc appends the text built by build as a link to url.
+func (f *TextFormatter) TextLinkFunc(url string, build func(f *TextFormatter)) *TextFormatter {
+	return f.wrap(MessageEntity{Type: "text_link", URL: &url}, build)
+}
+
+// TextMention appends text that mentions user, which also works for users without a username.
+func (f *TextFormatter) TextMention(text string, user User) *TextFormatter {
+	return f.TextMentionFunc(user, leaf(text))
+}
+
+// TextMentionFunc appends the text built by build as a mention of user.
+func (f *TextFormatter) TextMentionFunc(user User, build func(f *TextFormatter)) *TextFormatter {
+	return f.wrap(MessageEntity{Type: "text_mention", User: &user}, build)
+}
+
+// CustomEmoji appends a custom emoji. emoji is the alternative emoji shown where custom emoji are unavailable.
+func (f *TextFormatter) CustomEmoji(emoji, customEmojiID string) *TextFormatter {
+	return f.wrap(MessageEntity{Type: "custom_emoji", CustomEmojiID: &customEmojiID}, leaf(emoji))
+}
+
+// Blockquote appends a block quotation.
+func (f *TextFormatter) Blockquote(text string) *TextFormatter {
+	return f.BlockquoteFunc(leaf(text))
+}
+
+// BlockquoteFunc appends the text built by build as a block quotation.
+func (f *TextFormatter) BlockquoteFunc(build func(f *TextFormatter)) *TextFormatter {
+	return f.wrap(MessageEntity{Type: "blockquote"}, build)
+}
+
+// ExpandableBlockquote appends a block quotation that is collapsed by default.
+func (f *TextFormatter) ExpandableBlockquote(text string) *TextFormatter {
+	return f.ExpandableBlockquoteFunc(leaf(text))
+}
+
+// ExpandableBlockquoteFunc appends the text built by build as a block quotation that is collapsed by default.
+func (f *TextFormatter) ExpandableBlockquoteFunc(build func(f *TextFormatter)) *TextFormatter {
+	return f.wrap(MessageEntity{Type: "expandable_blockquote"}, build)
+}
+
+// Len returns the length of the text built so far in UTF-16 code units.
+func (f *TextFormatter) Len() int {
+	return f.length
+}
+
+// Build returns the text and its entities. Entities are ordered by offset, enclosing entities before the entities they contain.
+func (f *TextFormatter) Build() (string, []MessageEntity) {
+	entities := append([]MessageEntity(nil), f.entities...)
+	sortEntities(entities)
+	return f.text.String(), entities
+}
+
+// wrap appends the text built by build and covers it with entity. Empty entities are omitted.
+func (f *TextFormatter) wrap(entity MessageEntity, build func(f *TextFormatter)) *TextFormatter {
+	start := f.length
+	build(f)
+
+	entity.Offset = start
+	entity.Length = f.length - start
+	if entity.Length > 0 {
+		f.entities = append(f.entities, entity)
+	}
+	return f
+}
+
+// leaf returns a build function that appends plain text.
+func leaf(text string) func(f *TextFormatter) {
+	return func(f *TextFormatter) {
+		f.Text(text)
+	}
+}
+
+// sortEntities orders entities by offset, longer entities first, so that enclosing entities precede the entities they contain.
+func sortEntities(entities []MessageEntity) {
+	sort.SliceStable(entities, func(i, j int) bool {
+		if entities[i].Offset != entities[j].Offset {
+			return entities[i].Offset < entities[j].Offset
+		}
+		return entities[i].Length > entities[j].Length
+	})
+}
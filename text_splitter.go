@@ -0,0 +1,131 @@
+package telegram
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// Length limits of message texts and media captions, in UTF-16 code units after entities parsing.
+const (
+	MaxMessageTextLength = 4096
+	MaxCaptionLength     = 1024
+)
+
+// TextChunk is a part of a longer text together with the entities that fall into it.
+type TextChunk struct {
+	// Text of the chunk.
+	Text string
+
+	// Entities of the chunk, with offsets relative to the start of the chunk.
+	Entities []MessageEntity
+}
+
+// SplitText splits text with its entities into chunks of at most limit UTF-16 code units, e.g. MaxMessageTextLength.
+//
+// Chunks end at the last paragraph break, line break or space that keeps them within the limit, in that order of preference,
+// as long as that doesn't make the chunk shorter than half the limit; words longer than the limit are cut. The whitespace
+// a chunk is split at is dropped. Entities crossing a chunk boundary are split into one entity per chunk. Surrogate pairs
+// are never split, so with a limit of 1 a character outside the Basic Multilingual Plane makes a chunk of 2 code units.
+func SplitText(text string, entities []MessageEntity, limit int) []TextChunk {
+	units := utf16.Encode([]rune(text))
+	if limit <= 0 || len(units) <= limit {
+		return []TextChunk{{Text: text, Entities: entities}}
+	}
+
+	var chunks []TextChunk
+	start := 0
+	for start < len(units) {
+		end, next := len(units), len(units)
+		if len(units)-start > limit {
+			end = start + splitPoint(units[start:start+limit+1], limit)
+			next = end
+			for next < len(units) && (units[next] == '\n' || units[next] == ' ') {
+				next++
+			}
+		}
+
+		chunks = append(chunks, TextChunk{
+			Text:     string(utf16.Decode(units[start:end])),
+			Entities: sliceEntities(entities, start, end),
+		})
+		start = next
+	}
+	return chunks
+}
+
+// SplitCaption splits a media caption with its entities into the caption, at most MaxCaptionLength long,
+// and the remaining text split into chunks of at most MaxMessageTextLength, to be sent as separate messages.
+func SplitCaption(caption string, entities []MessageEntity) (TextChunk, []TextChunk) {
+	chunks := SplitText(caption, entities, MaxCaptionLength)
+	if len(chunks) == 1 {
+		return chunks[0], nil
+	}
+
+	// Join the rest back together, so that it is split at the limit of messages instead.
+	first := chunks[0]
+	rest := strings.TrimPrefix(caption, first.Text)
+	trimmed := strings.TrimLeft(rest, " \n")
+	offset := utf16Length(first.Text) + utf16Length(rest) - utf16Length(trimmed)
+
+	restEntities := sliceEntities(entities, offset, offset+utf16Length(trimmed))
+	return first, SplitText(trimmed, restEntities, MaxMessageTextLength)
+}
+
+// splitPoint returns where to end a chunk taken from window, which holds limit+1 code units so that a separator right
+// after the limit is found too.
+func splitPoint(window []uint16, limit int) int {
+	for _, separator := range [][]uint16{{'\n', '\n'}, {'\n'}, {' '}} {
+		if at := lastIndexUnits(window, separator); at > 0 && at >= limit/2 {
+			return at
+		}
+	}
+	for _, separator := range [][]uint16{{'\n'}, {' '}} {
+		if at := lastIndexUnits(window, separator); at > 0 {
+			return at
+		}
+	}
+
+	// No usable separator: cut at the limit, keeping surrogate pairs together. A limit of one code unit can't hold a pair,
+	// which is then taken whole, so that every chunk has at least one code point.
+	end := limit
+	if utf16.IsSurrogate(rune(window[end-1])) && window[end-1] < 0xdc00 {
+		end--
+	}
+	if end == 0 {
+		end = 2
+	}
+	return end
+}
+
+// lastIndexUnits returns the start of the last occurrence of separator in window, or -1.
+func lastIndexUnits(window []uint16, separator []uint16) int {
+	for at := len(window) - len(separator); at >= 0; at-- {
+		match := true
+		for i, unit := range separator {
+			if window[at+i] != unit {
+				match = false
+				break
+			}
+		}
+		if match {
+			return at
+		}
+	}
+	return -1
+}
+
+// sliceEntities returns the parts of entities that fall within [start, end), with offsets relative to start.
+func sliceEntities(entities []MessageEntity, start, end int) []MessageEntity {
+	var sliced []MessageEntity
+	for _, entity := range entities {
+		from := max(entity.Offset, start)
+		to := min(entity.Offset+entity.Length, end)
+		if to <= from {
+			continue
+		}
+		entity.Offset = from - start
+		entity.Length = to - from
+		sliced = append(sliced, entity)
+	}
+	return sliced
+}
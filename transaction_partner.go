@@ -0,0 +1,64 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// TransactionPartner describes the source of a transaction, or its recipient for outgoing transactions.
+// It can be one of TransactionPartnerUser, TransactionPartnerFragment, TransactionPartnerTelegramAds,
+// TransactionPartnerTelegramApi or TransactionPartnerOther; exactly one of the fields is set after decoding.
+//
+// See "TransactionPartner" https://core.telegram.org/bots/api#transactionpartner
+type TransactionPartner struct {
+	User        *TransactionPartnerUser
+	Fragment    *TransactionPartnerFragment
+	TelegramAds *TransactionPartnerTelegramAds
+	TelegramApi *TransactionPartnerTelegramApi
+	Other       *TransactionPartnerOther
+}
+
+// UnmarshalJSON decodes the variant named by the type field.
+func (p *TransactionPartner) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return fmt.Errorf("error decoding transaction partner: %w", err)
+	}
+
+	*p = TransactionPartner{}
+	switch probe.Type {
+	case "user":
+		p.User = new(TransactionPartnerUser)
+		return json.Unmarshal(data, p.User)
+	case "fragment":
+		p.Fragment = new(TransactionPartnerFragment)
+		return json.Unmarshal(data, p.Fragment)
+	case "telegram_ads":
+		p.TelegramAds = new(TransactionPartnerTelegramAds)
+		return json.Unmarshal(data, p.TelegramAds)
+	case "telegram_api":
+		p.TelegramApi = new(TransactionPartnerTelegramApi)
+		return json.Unmarshal(data, p.TelegramApi)
+	default:
+		p.Other = new(TransactionPartnerOther)
+		return json.Unmarshal(data, p.Other)
+	}
+}
+
+// MarshalJSON encodes whichever variant is set.
+func (p TransactionPartner) MarshalJSON() ([]byte, error) {
+	switch {
+	case p.User != nil:
+		return json.Marshal(p.User)
+	case p.Fragment != nil:
+		return json.Marshal(p.Fragment)
+	case p.TelegramAds != nil:
+		return json.Marshal(p.TelegramAds)
+	case p.TelegramApi != nil:
+		return json.Marshal(p.TelegramApi)
+	default:
+		return json.Marshal(p.Other)
+	}
+}
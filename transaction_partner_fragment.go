@@ -0,0 +1,12 @@
+package telegram
+
+// TransactionPartnerFragment describes a withdrawal transaction with Fragment.
+//
+// See "TransactionPartnerFragment" https://core.telegram.org/bots/api#transactionpartnerfragment
+type TransactionPartnerFragment struct {
+	// (Required) Type of the transaction partner, always “fragment”.
+	Type string `json:"type"`
+
+	// (Optional) State of the transaction if the transaction is outgoing.
+	WithdrawalState *RevenueWithdrawalState `json:"withdrawal_state,omitempty"`
+}
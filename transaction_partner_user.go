@@ -0,0 +1,24 @@
+package telegram
+
+// TransactionPartnerUser describes a transaction with a user.
+//
+// See "TransactionPartnerUser" https://core.telegram.org/bots/api#transactionpartneruser
+type TransactionPartnerUser struct {
+	// (Required) Type of the transaction partner, always “user”.
+	Type string `json:"type"`
+
+	// (Required) Information about the user.
+	User User `json:"user"`
+
+	// (Optional) Bot-specified invoice payload.
+	InvoicePayload *string `json:"invoice_payload,omitempty"`
+
+	// (Optional) The duration of the paid subscription.
+	SubscriptionPeriod *int `json:"subscription_period,omitempty"`
+
+	// (Optional) Information about the paid media bought by the user.
+	PaidMedia []PaidMedia `json:"paid_media,omitempty"`
+
+	// (Optional) Bot-specified paid media payload.
+	PaidMediaPayload *string `json:"paid_media_payload,omitempty"`
+}
@@ -0,0 +1,20 @@
+package telegram
+
+import "encoding/json"
+
+// rawVariant copies the JSON object of a union variant whose type this package doesn't know.
+//
+// The Bot API adds variants to unions such as ReactionType and ChatMember from time to time. Rather than failing to decode
+// the whole update, such a variant is decoded into the union's Unknown field, which keeps the object as it was received
+// so that encoding the union, e.g. to store or forward an update, doesn't lose any of its fields.
+func rawVariant(data []byte) json.RawMessage {
+	return append(json.RawMessage(nil), data...)
+}
+
+// marshalRawVariant returns raw if it isn't empty and encodes fallback otherwise, e.g. for a variant built by hand.
+func marshalRawVariant(raw json.RawMessage, fallback any) ([]byte, error) {
+	if len(raw) > 0 {
+		return raw, nil
+	}
+	return json.Marshal(fallback)
+}
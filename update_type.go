@@ -0,0 +1,193 @@
+package telegram
+
+// UpdateType is the name of an optional Update field, as used in allowed_updates.
+type UpdateType string
+
+// Update types, usable as allowed_updates values.
+const (
+	UpdateTypeMessage                 UpdateType = "message"
+	UpdateTypeEditedMessage           UpdateType = "edited_message"
+	UpdateTypeChannelPost             UpdateType = "channel_post"
+	UpdateTypeEditedChannelPost       UpdateType = "edited_channel_post"
+	UpdateTypeBusinessConnection      UpdateType = "business_connection"
+	UpdateTypeBusinessMessage         UpdateType = "business_message"
+	UpdateTypeEditedBusinessMessage   UpdateType = "edited_business_message"
+	UpdateTypeDeletedBusinessMessages UpdateType = "deleted_business_messages"
+	UpdateTypeMessageReaction         UpdateType = "message_reaction"
+	UpdateTypeMessageReactionCount    UpdateType = "message_reaction_count"
+	UpdateTypeInlineQuery             UpdateType = "inline_query"
+	UpdateTypeChosenInlineResult      UpdateType = "chosen_inline_result"
+	UpdateTypeCallbackQuery           UpdateType = "callback_query"
+	UpdateTypeShippingQuery           UpdateType = "shipping_query"
+	UpdateTypePreCheckoutQuery        UpdateType = "pre_checkout_query"
+	UpdateTypePurchasedPaidMedia      UpdateType = "purchased_paid_media"
+	UpdateTypePoll                    UpdateType = "poll"
+	UpdateTypePollAnswer              UpdateType = "poll_answer"
+	UpdateTypeMyChatMember            UpdateType = "my_chat_member"
+	UpdateTypeChatMember              UpdateType = "chat_member"
+	UpdateTypeChatJoinRequest         UpdateType = "chat_join_request"
+	UpdateTypeChatBoost               UpdateType = "chat_boost"
+	UpdateTypeRemovedChatBoost        UpdateType = "removed_chat_boost"
+)
+
+// AllUpdateTypes lists every update type. Passing it as allowed_updates also subscribes to chat_member, message_reaction
+// and message_reaction_count updates, which Telegram doesn't send by default.
+var AllUpdateTypes = []UpdateType{
+	UpdateTypeMessage, UpdateTypeEditedMessage, UpdateTypeChannelPost, UpdateTypeEditedChannelPost,
+	UpdateTypeBusinessConnection, UpdateTypeBusinessMessage, UpdateTypeEditedBusinessMessage, UpdateTypeDeletedBusinessMessages,
+	UpdateTypeMessageReaction, UpdateTypeMessageReactionCount, UpdateTypeInlineQuery, UpdateTypeChosenInlineResult,
+	UpdateTypeCallbackQuery, UpdateTypeShippingQuery, UpdateTypePreCheckoutQuery, UpdateTypePurchasedPaidMedia,
+	UpdateTypePoll, UpdateTypePollAnswer, UpdateTypeMyChatMember, UpdateTypeChatMember, UpdateTypeChatJoinRequest,
+	UpdateTypeChatBoost, UpdateTypeRemovedChatBoost,
+}
+
+// Kind returns the type of the update, i.e. the name of the optional field that is set, or an empty string if the update
+// carries no known payload.
+func (u Update) Kind() UpdateType {
+	switch {
+	case u.Message != nil:
+		return UpdateTypeMessage
+	case u.EditedMessage != nil:
+		return UpdateTypeEditedMessage
+	case u.ChannelPost != nil:
+		return UpdateTypeChannelPost
+	case u.EditedChannelPost != nil:
+		return UpdateTypeEditedChannelPost
+	case u.BusinessConnection != nil:
+		return UpdateTypeBusinessConnection
+	case u.BusinessMessage != nil:
+		return UpdateTypeBusinessMessage
+	case u.EditedBusinessMessage != nil:
+		return UpdateTypeEditedBusinessMessage
+	case u.DeletedBusinessMessages != nil:
+		return UpdateTypeDeletedBusinessMessages
+	case u.MessageReaction != nil:
+		return UpdateTypeMessageReaction
+	case u.MessageReactionCount != nil:
+		return UpdateTypeMessageReactionCount
+	case u.InlineQuery != nil:
+		return UpdateTypeInlineQuery
+	case u.ChosenInlineResult != nil:
+		return UpdateTypeChosenInlineResult
+	case u.CallbackQuery != nil:
+		return UpdateTypeCallbackQuery
+	case u.ShippingQuery != nil:
+		return UpdateTypeShippingQuery
+	case u.PreCheckoutQuery != nil:
+		return UpdateTypePreCheckoutQuery
+	case u.PurchasedPaidMedia != nil:
+		return UpdateTypePurchasedPaidMedia
+	case u.Poll != nil:
+		return UpdateTypePoll
+	case u.PollAnswer != nil:
+		return UpdateTypePollAnswer
+	case u.MyChatMember != nil:
+		return UpdateTypeMyChatMember
+	case u.ChatMember != nil:
+		return UpdateTypeChatMember
+	case u.ChatJoinRequest != nil:
+		return UpdateTypeChatJoinRequest
+	case u.ChatBoost != nil:
+		return UpdateTypeChatBoost
+	case u.RemovedChatBoost != nil:
+		return UpdateTypeRemovedChatBoost
+	default:
+		return ""
+	}
+}
+
+// message returns the message carried by the update: a new or edited message, channel post or business message,
+// or the accessible message of a callback query.
+func (u Update) message() *Message {
+	for _, message := range []*Message{u.Message, u.EditedMessage, u.ChannelPost, u.EditedChannelPost,
+		u.BusinessMessage, u.EditedBusinessMessage} {
+		if message != nil {
+			return message
+		}
+	}
+	if u.CallbackQuery != nil && u.CallbackQuery.Message != nil {
+		return u.CallbackQuery.Message.Message
+	}
+	return nil
+}
+
+// Chat returns the chat the update happened in, or nil if the update isn't tied to a chat, as with inline queries,
+// payments, polls and business connections.
+func (u Update) Chat() *Chat {
+	if message := u.message(); message != nil {
+		return &message.Chat
+	}
+
+	switch {
+	case u.CallbackQuery != nil && u.CallbackQuery.Message != nil:
+		chat := u.CallbackQuery.Message.Chat()
+		return &chat
+	case u.DeletedBusinessMessages != nil:
+		return &u.DeletedBusinessMessages.Chat
+	case u.MessageReaction != nil:
+		return &u.MessageReaction.Chat
+	case u.MessageReactionCount != nil:
+		return &u.MessageReactionCount.Chat
+	case u.MyChatMember != nil:
+		return &u.MyChatMember.Chat
+	case u.ChatMember != nil:
+		return &u.ChatMember.Chat
+	case u.ChatJoinRequest != nil:
+		return &u.ChatJoinRequest.Chat
+	case u.ChatBoost != nil:
+		return &u.ChatBoost.Chat
+	case u.RemovedChatBoost != nil:
+		return &u.RemovedChatBoost.Chat
+	default:
+		return nil
+	}
+}
+
+// Sender returns the user who caused the update, or nil if the update has no such user, e.g. for anonymous
+// reactions, channel posts or poll state changes.
+func (u Update) Sender() *User {
+	switch {
+	case u.CallbackQuery != nil:
+		return &u.CallbackQuery.From
+	case u.BusinessConnection != nil:
+		return &u.BusinessConnection.User
+	case u.MessageReaction != nil:
+		return u.MessageReaction.User
+	case u.InlineQuery != nil:
+		return &u.InlineQuery.From
+	case u.ChosenInlineResult != nil:
+		return &u.ChosenInlineResult.From
+	case u.ShippingQuery != nil:
+		return &u.ShippingQuery.From
+	case u.PreCheckoutQuery != nil:
+		return &u.PreCheckoutQuery.From
+	case u.PurchasedPaidMedia != nil:
+		return &u.PurchasedPaidMedia.From
+	case u.PollAnswer != nil:
+		return u.PollAnswer.User
+	case u.MyChatMember != nil:
+		return &u.MyChatMember.From
+	case u.ChatMember != nil:
+		return &u.ChatMember.From
+	case u.ChatJoinRequest != nil:
+		return &u.ChatJoinRequest.From
+	case u.ChatBoost != nil:
+		return u.ChatBoost.Boost.Source.User()
+	case u.RemovedChatBoost != nil:
+		return u.RemovedChatBoost.Source.User()
+	}
+
+	if message := u.message(); message != nil {
+		return message.From
+	}
+	return nil
+}
+
+// MessageThreadID returns the identifier of the forum topic or message thread the update's message belongs to,
+// or nil if there is none.
+func (u Update) MessageThreadID() *int {
+	if message := u.message(); message != nil {
+		return message.MessageThreadID
+	}
+	return nil
+}
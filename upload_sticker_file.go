@@ -0,0 +1,88 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"strconv"
+)
+
+// UploadStickerFileRequest represents a request to upload a file with a sticker for later use in the createNewStickerSet,
+// addStickerToSet, or replaceStickerInSet methods (the file can be used multiple times).
+//
+// See "uploadStickerFile" https://core.telegram.org/bots/api#uploadstickerfile
+type UploadStickerFileRequest struct {
+	// (Required) User identifier of sticker file owner.
+	UserID int64 `json:"user_id"`
+
+	// (Required) Path of a local file with the sticker in .WEBP, .PNG, .TGS, or .WEBM format.
+	// See https://core.telegram.org/stickers for technical requirements.
+	StickerPath string `json:"sticker"`
+
+	// (Required) Format of the sticker, must be one of “static”, “animated”, “video”.
+	StickerFormat string `json:"sticker_format"`
+}
+
+// UploadStickerFile uploads a file with a sticker for later use in the createNewStickerSet, addStickerToSet, or replaceStickerInSet
+// methods (the file can be used multiple times). Returns the uploaded File on success.
+//
+// See "uploadStickerFile" https://core.telegram.org/bots/api#uploadstickerfile
+func (b *Bot) UploadStickerFile(request UploadStickerFileRequest) (File, error) {
+	requestPayload := &bytes.Buffer{}
+	writer := multipart.NewWriter(requestPayload)
+
+	if err := writer.WriteField("user_id", strconv.FormatInt(request.UserID, 10)); err != nil {
+		return File{}, fmt.Errorf("error adding user ID field: %w", err)
+	}
+
+	if err := writer.WriteField("sticker_format", request.StickerFormat); err != nil {
+		return File{}, fmt.Errorf("error adding sticker format field: %w", err)
+	}
+
+	stickerFile, err := os.Open(request.StickerPath)
+	if err != nil {
+		return File{}, fmt.Errorf("error opening sticker file: %w", err)
+	}
+	defer stickerFile.Close()
+
+	fileWriter, err := writer.CreateFormFile("sticker", stickerFile.Name())
+	if err != nil {
+		return File{}, fmt.Errorf("error creating form file for sticker: %w", err)
+	}
+	if _, err := io.Copy(fileWriter, stickerFile); err != nil {
+		return File{}, fmt.Errorf("error copying sticker file: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return File{}, fmt.Errorf("error closing multipart writer: %w", err)
+	}
+
+	httpResponse, err := b.sendRequest("POST", writer.FormDataContentType(), "uploadStickerFile", requestPayload)
+	if err != nil {
+		return File{}, err
+	}
+	defer httpResponse.Body.Close()
+
+	var response struct {
+		Ok          bool               `json:"ok"`
+		Result      File               `json:"result"`
+		Description string             `json:"description"`
+		ErrorCode   int                `json:"error_code"`
+		Parameters  ResponseParameters `json:"parameters"`
+	}
+
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return File{}, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK || !response.Ok {
+		return File{}, fmt.Errorf("HTTP status %s, Telegram code %d, Telegram API error: %s",
+			httpResponse.Status, response.ErrorCode, response.Description)
+	}
+
+	return response.Result, nil
+}
@@ -1,3 +1,5 @@
 package telegram
 
 const telegramEndpoint = "https://api.telegram.org/bot%s/%s"
+
+const telegramFileEndpoint = "https://api.telegram.org/file/bot%s/%s"
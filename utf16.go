@@ -0,0 +1,19 @@
+package telegram
+
+// utf16Length returns the length of s in UTF-16 code units, the unit of MessageEntity offsets and lengths
+// and of Telegram's message length limits.
+func utf16Length(s string) int {
+	length := 0
+	for _, r := range s {
+		length += utf16RuneLength(r)
+	}
+	return length
+}
+
+// utf16RuneLength returns the number of UTF-16 code units needed to encode r.
+func utf16RuneLength(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
@@ -0,0 +1,23 @@
+package telegram
+
+// WebAppChat represents a chat in which the Mini App was opened.
+//
+// See "WebAppChat" https://core.telegram.org/bots/webapps#webappchat
+type WebAppChat struct {
+	// (Required) Unique identifier for this chat. This number may have more than 32 significant bits and some programming languages
+	// may have difficulty/silent defects in interpreting it. But it has at most 52 significant bits, so a signed 64-bit integer or
+	// double-precision float type are safe for storing this identifier.
+	ID int64 `json:"id"`
+
+	// (Required) Type of chat, can be either “group”, “supergroup” or “channel”.
+	Type string `json:"type"`
+
+	// (Required) Title of the chat.
+	Title string `json:"title"`
+
+	// (Optional) Username of the chat.
+	Username *string `json:"username,omitempty"`
+
+	// (Optional) URL of the chat's photo. The photo can be in .jpeg or .svg formats. Only returned for Mini Apps launched from the attachment menu.
+	PhotoURL *string `json:"photo_url,omitempty"`
+}
@@ -0,0 +1,44 @@
+package telegram
+
+// WebAppInitData contains data that is transferred to the Mini App when it is opened.
+//
+// See "WebAppInitData" https://core.telegram.org/bots/webapps#webappinitdata
+type WebAppInitData struct {
+	// (Optional) A unique identifier for the Mini App session, required for sending messages via the answerWebAppQuery method.
+	QueryID *string `json:"query_id,omitempty"`
+
+	// (Optional) An object containing data about the current user.
+	User *WebAppUser `json:"user,omitempty"`
+
+	// (Optional) An object containing data about the chat partner of the current user in the chat where the bot was launched
+	// via the attachment menu. Returned only for private chats and only for Mini Apps launched via the attachment menu.
+	Receiver *WebAppUser `json:"receiver,omitempty"`
+
+	// (Optional) An object containing data about the chat where the bot was launched via the attachment menu. Returned for supergroups,
+	// channels and group chats – only for Mini Apps launched via the attachment menu.
+	Chat *WebAppChat `json:"chat,omitempty"`
+
+	// (Optional) Type of the chat from which the Mini App was opened. Can be either “sender” for a private chat with the user opening
+	// the link, “private”, “group”, “supergroup”, or “channel”. Returned only for Mini Apps launched from direct links.
+	ChatType *string `json:"chat_type,omitempty"`
+
+	// (Optional) Global identifier, uniquely corresponding to the chat from which the Mini App was opened. Returned only for Mini Apps
+	// launched from a direct link.
+	ChatInstance *string `json:"chat_instance,omitempty"`
+
+	// (Optional) The value of the startattach parameter, passed via link. Only returned for Mini Apps when launched from the attachment
+	// menu via link.
+	StartParam *string `json:"start_param,omitempty"`
+
+	// (Optional) Time in seconds, after which a message can be sent via the answerWebAppQuery method.
+	CanSendAfter *int `json:"can_send_after,omitempty"`
+
+	// (Required) Unix time when the form was opened.
+	AuthDate int64 `json:"auth_date"`
+
+	// (Required) A hash of all passed parameters, which the bot server can use to check their validity.
+	Hash string `json:"hash"`
+
+	// (Optional) A signature of all passed parameters (except hash), which the third party can use to check their validity.
+	Signature *string `json:"signature,omitempty"`
+}
@@ -0,0 +1,47 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// webAppInitDataKey is the context key under which WebAppAuth stores the validated init data.
+type webAppInitDataKey struct{}
+
+// WebAppAuth returns an HTTP middleware that authenticates requests from the bot's Mini App.
+//
+// The Mini App must send its Telegram.WebApp.initData in the Authorization header using the "tma" scheme,
+// i.e. "Authorization: tma <initData>". Requests without valid, fresh init data are rejected with 401 Unauthorized.
+// The validated init data is available to the next handler through WebAppInitDataFromContext.
+func (b *Bot) WebAppAuth(maxAge time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			initData, ok := strings.CutPrefix(r.Header.Get("Authorization"), "tma ")
+			if !ok {
+				http.Error(w, "missing Mini App init data", http.StatusUnauthorized)
+				return
+			}
+
+			data, err := b.ValidateWebAppInitData(initData, maxAge)
+			if errors.Is(err, ErrWebAppInitDataExpired) {
+				http.Error(w, "Mini App init data has expired", http.StatusUnauthorized)
+				return
+			}
+			if err != nil {
+				http.Error(w, "invalid Mini App init data", http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), webAppInitDataKey{}, data)))
+		})
+	}
+}
+
+// WebAppInitDataFromContext returns the init data stored by WebAppAuth, if any.
+func WebAppInitDataFromContext(ctx context.Context) (WebAppInitData, bool) {
+	data, ok := ctx.Value(webAppInitDataKey{}).(WebAppInitData)
+	return data, ok
+}
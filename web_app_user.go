@@ -0,0 +1,38 @@
+package telegram
+
+// WebAppUser contains the data of the Mini App user.
+//
+// See "WebAppUser" https://core.telegram.org/bots/webapps#webappuser
+type WebAppUser struct {
+	// (Required) A unique identifier for the user or bot. This number may have more than 32 significant bits and some programming
+	// languages may have difficulty/silent defects in interpreting it. It has at most 52 significant bits, so a 64-bit integer or
+	// a double-precision float type is safe for storing this identifier.
+	ID int64 `json:"id"`
+
+	// (Optional) True, if this user is a bot. Returns in the receiver field only.
+	IsBot *bool `json:"is_bot,omitempty"`
+
+	// (Required) First name of the user or bot.
+	FirstName string `json:"first_name"`
+
+	// (Optional) Last name of the user or bot.
+	LastName *string `json:"last_name,omitempty"`
+
+	// (Optional) Username of the user or bot.
+	Username *string `json:"username,omitempty"`
+
+	// (Optional) IETF language tag of the user's language. Returns in user field only.
+	LanguageCode *string `json:"language_code,omitempty"`
+
+	// (Optional) True, if this user is a Telegram Premium user.
+	IsPremium *bool `json:"is_premium,omitempty"`
+
+	// (Optional) True, if this user added the bot to the attachment menu.
+	AddedToAttachmentMenu *bool `json:"added_to_attachment_menu,omitempty"`
+
+	// (Optional) True, if this user allowed the bot to message them.
+	AllowsWriteToPM *bool `json:"allows_write_to_pm,omitempty"`
+
+	// (Optional) URL of the user's profile photo. The photo can be in .jpeg or .svg formats.
+	PhotoURL *string `json:"photo_url,omitempty"`
+}
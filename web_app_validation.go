@@ -0,0 +1,143 @@
+package telegram
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrWebAppInitDataInvalid is returned when Mini App init data is malformed or its hash or signature doesn't match.
+var ErrWebAppInitDataInvalid = errors.New("web app init data is malformed or has an invalid signature")
+
+// ErrWebAppInitDataExpired is returned when Mini App init data is older than the allowed age.
+var ErrWebAppInitDataExpired = errors.New("web app init data has expired")
+
+// ValidateWebAppInitData checks the hash of the init data a Mini App received from Telegram (Telegram.WebApp.initData)
+// and returns it parsed. Init data older than maxAge is rejected; a maxAge of zero or less disables the check.
+//
+// The hash is the hex-encoded HMAC-SHA256 of the data-check-string, keyed with HMAC-SHA256 of the bot token keyed with "WebAppData".
+//
+// See "Validating data received via the Mini App" https://core.telegram.org/bots/webapps#validating-data-received-via-the-mini-app
+func (b *Bot) ValidateWebAppInitData(initData string, maxAge time.Duration) (WebAppInitData, error) {
+	values, err := url.ParseQuery(initData)
+	if err != nil {
+		return WebAppInitData{}, ErrWebAppInitDataInvalid
+	}
+
+	hash, err := hex.DecodeString(values.Get("hash"))
+	if err != nil || len(hash) == 0 {
+		return WebAppInitData{}, ErrWebAppInitDataInvalid
+	}
+
+	secretKey := hmac.New(sha256.New, []byte("WebAppData"))
+	secretKey.Write([]byte(b.Token))
+
+	expected := hmac.New(sha256.New, secretKey.Sum(nil))
+	expected.Write([]byte(dataCheckString(values, "hash")))
+	if !hmac.Equal(hash, expected.Sum(nil)) {
+		return WebAppInitData{}, ErrWebAppInitDataInvalid
+	}
+
+	return parseWebAppInitData(values, maxAge)
+}
+
+// ValidateWebAppInitDataForThirdParty checks the Ed25519 signature of Mini App init data without knowing the bot token
+// and returns it parsed. botID is the identifier of the bot the Mini App belongs to, and publicKey is Telegram's public key
+// for the environment the bot runs in, as published in the Mini Apps documentation. Init data older than maxAge is rejected;
+// a maxAge of zero or less disables the check.
+//
+// See "Validating data for Third-Party Use" https://core.telegram.org/bots/webapps#validating-data-for-third-party-use
+func ValidateWebAppInitDataForThirdParty(initData string, botID int64, publicKey ed25519.PublicKey, maxAge time.Duration) (WebAppInitData, error) {
+	values, err := url.ParseQuery(initData)
+	if err != nil {
+		return WebAppInitData{}, ErrWebAppInitDataInvalid
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(values.Get("signature"), "="))
+	if err != nil || len(signature) != ed25519.SignatureSize || len(publicKey) != ed25519.PublicKeySize {
+		return WebAppInitData{}, ErrWebAppInitDataInvalid
+	}
+
+	message := strconv.FormatInt(botID, 10) + ":WebAppData\n" + dataCheckString(values, "hash", "signature")
+	if !ed25519.Verify(publicKey, []byte(message), signature) {
+		return WebAppInitData{}, ErrWebAppInitDataInvalid
+	}
+
+	return parseWebAppInitData(values, maxAge)
+}
+
+// dataCheckString joins all fields except the excluded ones as "key=value" lines sorted alphabetically.
+func dataCheckString(values url.Values, exclude ...string) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		excluded := false
+		for _, name := range exclude {
+			excluded = excluded || key == name
+		}
+		if !excluded {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	lines := make([]string, len(keys))
+	for i, key := range keys {
+		lines[i] = key + "=" + values.Get(key)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// parseWebAppInitData decodes verified init data fields and checks their age.
+func parseWebAppInitData(values url.Values, maxAge time.Duration) (WebAppInitData, error) {
+	authDate, err := strconv.ParseInt(values.Get("auth_date"), 10, 64)
+	if err != nil {
+		return WebAppInitData{}, fmt.Errorf("%w: invalid auth_date", ErrWebAppInitDataInvalid)
+	}
+	if maxAge > 0 && time.Since(time.Unix(authDate, 0)) > maxAge {
+		return WebAppInitData{}, ErrWebAppInitDataExpired
+	}
+
+	data := WebAppInitData{AuthDate: authDate, Hash: values.Get("hash")}
+
+	optionalString := func(key string) *string {
+		if !values.Has(key) {
+			return nil
+		}
+		value := values.Get(key)
+		return &value
+	}
+	data.QueryID = optionalString("query_id")
+	data.ChatType = optionalString("chat_type")
+	data.ChatInstance = optionalString("chat_instance")
+	data.StartParam = optionalString("start_param")
+	data.Signature = optionalString("signature")
+
+	if values.Has("can_send_after") {
+		canSendAfter, err := strconv.Atoi(values.Get("can_send_after"))
+		if err != nil {
+			return WebAppInitData{}, fmt.Errorf("%w: invalid can_send_after", ErrWebAppInitDataInvalid)
+		}
+		data.CanSendAfter = &canSendAfter
+	}
+
+	for key, target := range map[string]any{"user": &data.User, "receiver": &data.Receiver, "chat": &data.Chat} {
+		if !values.Has(key) {
+			continue
+		}
+		if err := json.Unmarshal([]byte(values.Get(key)), target); err != nil {
+			return WebAppInitData{}, fmt.Errorf("%w: invalid %s: %v", ErrWebAppInitDataInvalid, key, err)
+		}
+	}
+
+	return data, nil
+}